@@ -0,0 +1,118 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+const (
+	actionServerDeployDesc = `
+Build and deploy a custom action server from the project directory.
+
+The command builds an image from the 'actions/Dockerfile' file using the project directory
+as the build context, loads the image to the kind nodes used by the deployment,
+and upgrades the deployment so that the action server (the 'app' component) uses the image.
+
+The project directory of the deployment is used by default (see 'rasactl start --project').
+`
+
+	actionServerDeployExample = `
+	# Build and deploy an action server for the currently active deployment.
+	$ rasactl action-server deploy
+
+	# Build and deploy an action server from the /path/to/project directory.
+	$ rasactl action-server deploy my-deployment --project-path /path/to/project
+
+	# Build and deploy an action server with a given image name and tag.
+	$ rasactl action-server deploy --image my-actions --tag 1.0.0
+`
+)
+
+func actionServerDeployCmd() *cobra.Command {
+
+	// cmd represents the action-server deploy command
+	cmd := &cobra.Command{
+		Use:     "deploy [DEPLOYMENT-NAME]",
+		Short:   "build and deploy a custom action server from the project directory",
+		Long:    templates.LongDesc(actionServerDeployDesc),
+		Example: templates.Examples(actionServerDeployExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.CheckHelmChartDir()
+
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			// Keep the current values and the chart version, only the action server image is changed.
+			helmConfiguration.ReleaseName = string(stateData[types.StateHelmReleaseName])
+			helmConfiguration.Version = string(stateData[types.StateHelmChartVersion])
+			helmConfiguration.ReuseValues = true
+			rasaCtl.HelmClient.SetConfiguration(helmConfiguration)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(helmConfiguration.ReleaseName)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Check if a Rasa X deployment is running
+			_, isRunning, err := rasaCtl.CheckDeploymentStatus()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if !isRunning {
+				fmt.Printf("The %s deployment is not running.\n", rasaCtl.Namespace)
+				return nil
+			}
+
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.DeployActionServer(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addActionServerDeployFlags(cmd)
+
+	return cmd
+}
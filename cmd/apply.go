@@ -0,0 +1,136 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+const (
+	applyDesc = `
+Apply a deployment manifest.
+
+The manifest is a YAML document that describes a desired state of a deployment.
+The command compares the manifest with the current state of the deployment and
+creates, starts or upgrades the deployment, and activates an Enterprise license if it's required.
+
+Example of the manifest:
+
+  apiVersion: rasactl.rasa.com/v1alpha1
+  kind: Deployment
+  metadata:
+    name: my-deployment
+  spec:
+    chartVersion: 4.3.3
+    valuesFiles:
+      - values.yaml
+    projectPath: ./my-project
+    enterprise:
+      license:
+        file: ./license.txt
+    rasaX:
+      password:
+        env: RASA_X_PASSWORD
+
+Relative paths are resolved against the manifest directory.
+The Rasa X password is used only when a deployment is created.
+`
+
+	applyExample = `
+	# Apply the deployment.yaml manifest.
+	$ rasactl apply -f deployment.yaml
+
+	# Show changes without applying them.
+	$ rasactl apply -f deployment.yaml --dry-run
+`
+)
+
+func applyCmd() *cobra.Command {
+	var manifest *types.DeploymentManifest
+
+	// cmd represents the apply command
+	cmd := &cobra.Command{
+		Use:     "apply -f FILE",
+		Short:   "apply a deployment manifest",
+		Long:    applyDesc,
+		Example: templates.Examples(applyExample),
+		Args:    cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.CheckHelmChartDir()
+
+			m, err := utils.ReadManifest(rasactlFlags.Apply.File)
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+			manifest = m
+
+			if _, err := parseArgs(namespace, []string{manifest.Metadata.Name}, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			helmConfiguration.ReleaseName = manifest.Spec.ReleaseName
+			if helmConfiguration.ReleaseName == "" {
+				helmConfiguration.ReleaseName = types.HelmChartNameRasaX
+			}
+
+			helmConfiguration.Version = manifest.Spec.ChartVersion
+			if rasaCtl.KubernetesClient.IsSecretWithStateExist() {
+				stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+				if err != nil {
+					return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+				}
+				helmConfiguration.ReleaseName = string(stateData[types.StateHelmReleaseName])
+
+				// Keep the chart version of an existing deployment if the manifest doesn't define it.
+				if helmConfiguration.Version == "" {
+					helmConfiguration.Version = string(stateData[types.StateHelmChartVersion])
+				}
+			}
+
+			if helmConfiguration.Version == "" {
+				helmConfiguration.Version = types.HelmChartVersionRasaX
+			}
+
+			rasactlFlags.Start.RasaXPassword = types.RasaXDefaultPassword
+			rasaCtl.HelmClient.SetConfiguration(helmConfiguration)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(helmConfiguration.ReleaseName)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.Apply(manifest); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+			return nil
+		},
+	}
+
+	addApplyFlags(cmd)
+
+	return cmd
+}
+
+func init() {
+
+	applyCmd := applyCmd()
+	rootCmd.AddCommand(applyCmd)
+}
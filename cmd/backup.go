@@ -0,0 +1,116 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	backupDesc = `
+Create a backup of a Rasa X deployment.
+
+The backup is a single archive that includes a PostgreSQL dump, models,
+helm values and the rasactl state of the deployment.
+The archive can be used by the 'rasactl restore' command to recreate the deployment.
+`
+
+	backupExample = `
+	# Create a backup of the currently active deployment.
+	# The archive is stored in the current working directory.
+	$ rasactl backup
+
+	# Create a backup of the 'my-deployment' deployment and save it to the /tmp/my-deployment.tar.gz file.
+	$ rasactl backup my-deployment --output /tmp/my-deployment.tar.gz
+`
+)
+
+func backupCmd() *cobra.Command {
+
+	// cmd represents the backup command
+	cmd := &cobra.Command{
+		Use:     "backup [DEPLOYMENT-NAME]",
+		Short:   "create a backup of Rasa X deployment",
+		Long:    templates.LongDesc(backupDesc),
+		Example: templates.Examples(backupExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+			rasaCtl.HelmClient.SetConfiguration(
+				&types.HelmConfigurationSpec{
+					ReleaseName: string(stateData[types.StateHelmReleaseName]),
+				},
+			)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(string(stateData[types.StateHelmReleaseName]))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Check if a Rasa X deployment is running
+			_, isRunning, err := rasaCtl.CheckDeploymentStatus()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if !isRunning {
+				fmt.Printf("The %s deployment is not running.\n", rasaCtl.Namespace)
+				return nil
+			}
+
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.Backup(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addBackupFlags(cmd)
+
+	return cmd
+}
+
+func init() {
+
+	backupCmd := backupCmd()
+	rootCmd.AddCommand(backupCmd)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+const (
+	clusterCreateDesc = `
+Create a local kind cluster that is ready to use with rasactl.
+
+The command creates a kind cluster with the configuration that exposes ports 80, 443
+and the 30000-30100 node port range, adds the 'rasactl.localhost' zone to CoreDNS,
+and installs the ingress-nginx controller.
+
+The command requires kind to be installed. If the cluster already exists,
+only CoreDNS and the ingress controller are configured.
+`
+
+	clusterCreateExample = `
+	# Create a kind cluster.
+	$ rasactl cluster create
+
+	# Create a kind cluster with a custom configuration and a node image.
+	$ rasactl cluster create --kind-config kind/config.yaml --image kindest/node:v1.21.1
+`
+)
+
+func clusterCreateCmd() *cobra.Command {
+
+	// cmd represents the cluster create command
+	cmd := &cobra.Command{
+		Use:     "create",
+		Short:   "create a local kind cluster",
+		Long:    templates.LongDesc(clusterCreateDesc),
+		Example: templates.Examples(clusterCreateExample),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.ClusterCreate(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addClusterCreateFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+const (
+	clusterDeleteDesc = `
+Delete a local kind cluster.
+
+All deployments running in the cluster are deleted along with the cluster.
+`
+
+	clusterDeleteExample = `
+	# Delete the kind cluster.
+	$ rasactl cluster delete
+
+	# Delete the 'rasa' kind cluster.
+	$ rasactl cluster delete --name rasa
+`
+)
+
+func clusterDeleteCmd() *cobra.Command {
+
+	// cmd represents the cluster delete command
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "delete a local kind cluster",
+		Long:    templates.LongDesc(clusterDeleteDesc),
+		Example: templates.Examples(clusterDeleteExample),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.ClusterDelete(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addClusterNameFlag(cmd)
+
+	return cmd
+}
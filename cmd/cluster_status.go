@@ -0,0 +1,63 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+const (
+	clusterStatusDesc = `
+Show the status of the kind cluster used by the current Kubernetes context.
+
+The command shows the kind control plane node, the Kubernetes version,
+and whether the 'rasactl.localhost' CoreDNS zone and an ingress controller are configured.
+`
+
+	clusterStatusExample = `
+	# Show the status of the kind cluster.
+	$ rasactl cluster status
+
+	# Show the status in the JSON format.
+	$ rasactl cluster status -o json
+`
+)
+
+func clusterStatusCmd() *cobra.Command {
+
+	// cmd represents the cluster status command
+	cmd := &cobra.Command{
+		Use:     "status",
+		Short:   "show the status of a local kind cluster",
+		Long:    templates.LongDesc(clusterStatusDesc),
+		Example: templates.Examples(clusterStatusExample),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.ClusterStatus(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addClusterStatusFlags(cmd)
+
+	return cmd
+}
@@ -30,6 +30,7 @@ func configCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(configUseDeploymentCmd())
+	cmd.AddCommand(configGenerateEndpointsCmd())
 
 	configFlags(cmd)
 
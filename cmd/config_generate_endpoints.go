@@ -0,0 +1,103 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	configGenerateEndpointsDesc = `
+Generate the endpoints file that points a Rasa server at a Rasa X deployment.
+
+The endpoints file configures the model server, tracker store and event broker of the deployment,
+credentials are read from the deployment secrets. The credentials file with the Rasa X URL
+is generated if the --credentials-output flag is set.
+
+The --address flag defines how the Rasa server reaches services of the deployment:
+
+* nodeport - use node ports, available after a Rasa server has been connected with 'rasactl connect rasa'
+* port-forward - forward local ports to the services, ports are forwarded as long as the command runs
+* cluster-dns - use the cluster DNS names, for Rasa servers that run inside the cluster
+`
+
+	configGenerateEndpointsExample = `
+	# Print the endpoints file for the current deployment.
+	$ rasactl config generate-endpoints
+
+	# Generate the endpoints and credentials files for a Rasa server that runs inside the cluster.
+	$ rasactl config generate-endpoints --address cluster-dns -o endpoints.yml --credentials-output credentials.yml
+
+	# Forward ports to the deployment services and generate the endpoints file.
+	$ rasactl config generate-endpoints --address port-forward -o endpoints.yml
+`
+)
+
+func configGenerateEndpointsCmd() *cobra.Command {
+
+	// cmd represents the config generate-endpoints command
+	cmd := &cobra.Command{
+		Use:     "generate-endpoints [DEPLOYMENT-NAME]",
+		Short:   "generate the endpoints file for Rasa OSS",
+		Long:    templates.LongDesc(configGenerateEndpointsDesc),
+		Example: templates.Examples(configGenerateEndpointsExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			rasaCtl.HelmClient.SetConfiguration(
+				&types.HelmConfigurationSpec{
+					ReleaseName: string(stateData[types.StateHelmReleaseName]),
+				},
+			)
+
+			rasaCtl.KubernetesClient.SetHelmReleaseName(string(stateData[types.StateHelmReleaseName]))
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+
+			if err := rasaCtl.GenerateEndpoints(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addConfigGenerateEndpointsFlags(cmd)
+
+	return cmd
+}
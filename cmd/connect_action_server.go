@@ -0,0 +1,112 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	connectActionServerDesc = `
+Connect a locally running action server to Rasa X deployment.
+
+The command configures Rasa servers of the deployment to use an action server that runs locally,
+instead of the action server deployed in the cluster. The configuration is reverted when the command is interrupted.
+If the command is killed before the configuration is reverted, use the 'rasactl disconnect action-server' command.
+
+The command works only if Rasa X deployment runs on a local Kubernetes cluster managed with 'kind'.
+`
+
+	connectActionServerExample = `
+	# Connect an action server that runs at the 5055 port.
+	$ rasactl connect action-server
+
+	# Connect an action server that runs at the 5056 port.
+	$ rasactl connect action-server --port 5056
+`
+)
+
+func connectActionServerCmd() *cobra.Command {
+
+	// cmd represents the connect action-server command
+	cmd := &cobra.Command{
+		Use:     "action-server [DEPLOYMENT-NAME]",
+		Short:   "connect a locally running action server to the Rasa X deployment",
+		Long:    connectActionServerDesc,
+		Args:    cobra.MaximumNArgs(1),
+		Example: templates.Examples(connectActionServerExample),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			helmConfiguration.ReleaseName = string(stateData[types.StateHelmReleaseName])
+			helmConfiguration.ReuseValues = true
+			rasaCtl.HelmClient.SetConfiguration(helmConfiguration)
+
+			rasaCtl.KubernetesClient.SetHelmReleaseName(string(stateData[types.StateHelmReleaseName]))
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			// Check if a Rasa X deployment is already installed and running
+			_, isRunning, err := rasaCtl.CheckDeploymentStatus()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if !isRunning {
+				fmt.Printf("Rasa X for the %s deployment is not running.\n", rasaCtl.Namespace)
+				return nil
+			}
+
+			if err := rasaCtl.ConnectActionServer(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addConnectActionServerFlags(cmd)
+
+	return cmd
+}
@@ -32,10 +32,18 @@ const (
 Connect Rasa OSS (Open Source Server) to Rasa X deployment.
 
 The command prepares a configuration that's required to connect Rasa X deployment and run a local Rasa server.
+A local Rasa server that exits unexpectedly is restarted automatically.
 
-It's required to have the 'rasa' command accessible by rasactl.
+It's required to have the 'rasa' command accessible by rasactl. If the --docker flag is used,
+the Rasa server runs in a Docker container that uses the rasa/rasa image instead,
+the image version matches the Rasa version reported by Rasa X unless --rasa-version is set.
 
-The command works only if Rasa X deployment runs on a local Kubernetes cluster managed with 'kind'.
+The command works only if Rasa X deployment runs on a local Kubernetes cluster managed with 'kind',
+unless the --port-forward flag is used. With --port-forward, local ports are forwarded to PostgreSQL,
+RabbitMQ and Rasa X, so that a local Rasa server can be attached to a deployment on a remote cluster.
+The deployment configuration is not changed in this mode.
+
+Use the 'rasactl disconnect rasa' command to revert changes made to the deployment.
 `
 
 	connectRasaExample = `
@@ -47,6 +55,12 @@ The command works only if Rasa X deployment runs on a local Kubernetes cluster m
 
 	# Pass extra arguments to rasa server.
 	$ rasactl connect rasa --extra-args="--debug"
+
+	# Run Rasa Server in a Docker container.
+	$ rasactl connect rasa --docker
+
+	# Connect Rasa Server to Rasa X deployment that runs on a remote cluster.
+	$ rasactl connect rasa --port-forward
 `
 )
 
@@ -61,10 +75,11 @@ func connectRasaCmd() *cobra.Command {
 		Example: templates.Examples(connectRasaExample),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 
-			if !utils.CommandExists("rasa") {
+			if !rasactlFlags.ConnectRasa.Docker && !utils.CommandExists("rasa") {
 				return xerrors.Errorf(
 					errorPrint.Sprint(
-						"The 'rasa' command doesn't exist. Check out the docs to learn how to install rasa, https://rasa.com/docs/rasa/installation/",
+						"The 'rasa' command doesn't exist. Check out the docs to learn how to install rasa, https://rasa.com/docs/rasa/installation/," +
+							" or use the --docker flag to run the Rasa server in a Docker container",
 					),
 				)
 			}
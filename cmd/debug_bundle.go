@@ -0,0 +1,101 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	debugBundleDesc = `
+Collect information required to debug a Rasa X deployment in a single archive.
+
+The bundle includes the helm release and helm values with passwords and tokens redacted,
+keys of the rasactl state, pods and their statuses, namespace events, cluster nodes, recent logs
+from every container, responses from the Rasa X /api/health and /api/version endpoints,
+the Docker server version and kind node details.
+
+The bundle is created even if the deployment is not running, data that can't be collected
+is listed in the errors.txt file in the bundle. Please attach the bundle when you report an issue.
+`
+
+	debugBundleExample = `
+	# Create a debug bundle for the currently active deployment.
+	# The archive is stored in the current working directory.
+	$ rasactl debug bundle
+
+	# Create a debug bundle for the 'my-deployment' deployment and collect 5000 recent log lines from each container.
+	$ rasactl debug bundle my-deployment --tail 5000 --output /tmp/my-deployment-debug.tar.gz
+`
+)
+
+func debugBundleCmd() *cobra.Command {
+
+	// cmd represents the debug bundle command
+	cmd := &cobra.Command{
+		Use:     "bundle [DEPLOYMENT-NAME]",
+		Short:   "collect information required to debug a deployment in a single archive",
+		Long:    templates.LongDesc(debugBundleDesc),
+		Example: templates.Examples(debugBundleExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+			rasaCtl.HelmClient.SetConfiguration(
+				&types.HelmConfigurationSpec{
+					ReleaseName: string(stateData[types.StateHelmReleaseName]),
+				},
+			)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(string(stateData[types.StateHelmReleaseName]))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.DebugBundle(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addDebugBundleFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,98 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	disconnectActionServerDesc = `
+Disconnect a locally running action server from Rasa X deployment.
+
+The 'rasactl connect action-server' command reverts its changes when it's interrupted. If the command
+has been killed before the configuration was reverted, Rasa servers of the deployment still use the local
+action server. This command restores the configuration stored in the deployment state by 'connect action-server'.
+`
+
+	disconnectActionServerExample = `
+	# Disconnect a local action server from the currently active deployment.
+	$ rasactl disconnect action-server
+`
+)
+
+func disconnectActionServerCmd() *cobra.Command {
+
+	// cmd represents the disconnect action-server command
+	cmd := &cobra.Command{
+		Use:     "action-server [DEPLOYMENT-NAME]",
+		Short:   "revert changes made by the 'connect action-server' command",
+		Long:    disconnectActionServerDesc,
+		Args:    cobra.MaximumNArgs(1),
+		Example: templates.Examples(disconnectActionServerExample),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			rasaCtl.HelmClient.SetConfiguration(
+				&types.HelmConfigurationSpec{
+					ReleaseName: string(stateData[types.StateHelmReleaseName]),
+					ReuseValues: true,
+					Timeout:     time.Minute * 10,
+				},
+			)
+
+			rasaCtl.KubernetesClient.SetHelmReleaseName(string(stateData[types.StateHelmReleaseName]))
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			if err := rasaCtl.DisconnectActionServer(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+const (
+	doctorDesc = `
+Check if the environment is ready to run a Rasa X deployment.
+
+The command checks the Docker version, the kind cluster required by deployments with a local project,
+whether *.rasactl.localhost addresses are resolved by the cluster DNS, the ingress controller,
+the default storage class, free CPU and memory on each cluster node compared to resources requested by the rasa-x helm chart,
+the 'rasa' command used by 'rasactl connect rasa', and the credential helper used by 'rasactl auth login'.
+
+Each check reports pass, warn or fail, checks that haven't passed include a hint how to fix the problem.
+The same checks, except the last two, run before a deployment is started by 'rasactl start'.
+`
+
+	doctorExample = `
+	# Run checks for the currently active deployment, or for a new deployment if there are no deployments.
+	$ rasactl doctor
+
+	# Run checks for a new deployment that uses a local Rasa project and a custom configuration.
+	$ rasactl doctor my-deployment --project --values-file custom-configuration.yaml
+`
+)
+
+func doctorCmd() *cobra.Command {
+
+	// cmd represents the doctor command
+	cmd := &cobra.Command{
+		Use:     "doctor [DEPLOYMENT-NAME]",
+		Short:   "check if the environment is ready to run Rasa X",
+		Long:    templates.LongDesc(doctorDesc),
+		Example: templates.Examples(doctorExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.CheckHelmChartDir()
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if rasaCtl.KubernetesClient.IsSecretWithStateExist() {
+				stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+				if err != nil {
+					return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+				}
+
+				helmConfiguration.ReleaseName = string(stateData[types.StateHelmReleaseName])
+				if helmConfiguration.Version == "" {
+					helmConfiguration.Version = string(stateData[types.StateHelmChartVersion])
+				}
+			}
+
+			if helmConfiguration.Version == "" {
+				helmConfiguration.Version = types.HelmChartVersionRasaX
+			}
+
+			rasaCtl.HelmClient.SetConfiguration(helmConfiguration)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(helmConfiguration.ReleaseName)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.Doctor(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addDoctorFlags(cmd)
+
+	return cmd
+}
+
+func init() {
+
+	doctorCmd := doctorCmd()
+	rootCmd.AddCommand(doctorCmd)
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -11,6 +13,8 @@ import (
 func addStartUpgradeFlags(cmd *cobra.Command) {
 	cmd.Flags().DurationVar(&helmConfiguration.Timeout, "wait-timeout", time.Minute*15, "time to wait for Rasa X to be ready")
 	cmd.PersistentFlags().StringVar(&rasactlFlags.StartUpgrade.ValuesFile, "values-file", "", "absolute path to the values file")
+	cmd.PersistentFlags().StringVar(&rasactlFlags.StartUpgrade.ChartPath, "rasa-x-chart", "",
+		"path to a local rasa-x helm chart, a packaged chart (.tgz) or an unpacked chart directory, the chart repository is not used if set")
 }
 
 func addStartFlags(cmd *cobra.Command) {
@@ -23,13 +27,20 @@ func addStartFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolVarP(&rasactlFlags.Start.Project, "project", "p", false,
 		"use the current working directory as a project directory, the flag is ignored if --project-path is used")
 
-	cmd.PersistentFlags().StringVar(&rasactlFlags.Start.RasaXPassword, "rasa-x-password", "rasaxlocal", "Rasa X password")
+	cmd.PersistentFlags().StringVar(&rasactlFlags.Start.RasaXPassword, "rasa-x-password", types.RasaXDefaultPassword, "Rasa X password")
 	cmd.PersistentFlags().BoolVar(&rasactlFlags.Start.RasaXPasswordStdin, "rasa-x-password-stdin", false, "read the Rasa X password from stdin")
 	cmd.Flags().BoolVar(&rasactlFlags.Start.UseEdgeRelease, "rasa-x-edge-release", false, "use the latest edge release of Rasa X")
+	cmd.Flags().BoolVar(&rasactlFlags.Start.PreloadImages, "preload-images", false,
+		"pull images used by the deployment and load them to kind nodes before Rasa X is deployed")
 	cmd.Flags().BoolVar(&rasactlFlags.Start.Create, "create", false,
 		"create a new deployment. If --project or --project-path is set, or there is no existing deployment,"+
 			" the flag is not required to create a new deployment")
+	addPreflightFlags(cmd)
+}
 
+func addPreflightFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&rasactlFlags.Start.SkipPreflight, "skip-preflight-checks", false,
+		"don't run preflight checks before the deployment is started")
 }
 
 func addUpgradeFlags(cmd *cobra.Command) {
@@ -37,6 +48,10 @@ func addUpgradeFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&helmConfiguration.Version, "rasa-x-chart-version", "", "a helm chart version to use")
 	cmd.Flags().BoolVar(&helmConfiguration.ReuseValues, "reuse-values", true,
 		"when upgrading, reuse the last release's values and merge in any overrides")
+	cmd.Flags().BoolVar(&rasactlFlags.Upgrade.DryRun, "dry-run", false,
+		"render the upgrade and print resources that would be changed without applying it")
+	cmd.Flags().BoolVar(&rasactlFlags.Upgrade.Diff, "diff", false,
+		"print a diff between the deployed and the upgraded manifests and values, requires --dry-run, sensitive values are redacted")
 }
 
 func addDeleteFlags(cmd *cobra.Command) {
@@ -60,6 +75,17 @@ func addConnectRasaFlags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&rasactlFlags.ConnectRasa.RunSeparateWorker, "run-separate-worker", false,
 		"runs a separate Rasa server for the worker environment")
 	cmd.Flags().StringSliceVar(&rasactlFlags.ConnectRasa.ExtraArgs, "extra-args", nil, "extra arguments for Rasa server")
+	cmd.Flags().BoolVar(&rasactlFlags.ConnectRasa.Docker, "docker", false,
+		"run the Rasa server in a Docker container instead of using the local 'rasa' command")
+	cmd.Flags().StringVar(&rasactlFlags.ConnectRasa.RasaVersion, "rasa-version", "",
+		"version of the rasa/rasa image used with --docker, the Rasa version reported by Rasa X is used if empty")
+	cmd.Flags().BoolVar(&rasactlFlags.ConnectRasa.PortForward, "port-forward", false,
+		"forward local ports to PostgreSQL, RabbitMQ and Rasa X instead of using node ports, required for remote clusters")
+}
+
+func addConnectActionServerFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVarP(&rasactlFlags.ConnectActionServer.Port, "port", "p", 5055, "port the local action server runs at")
+	cmd.Flags().DurationVar(&helmConfiguration.Timeout, "wait-timeout", time.Minute*10, "time to wait for Rasa X to be ready")
 }
 
 func addAuthLoginFlags(cmd *cobra.Command) {
@@ -72,6 +98,15 @@ func configFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolVar(&rasactlFlags.Config.CreateFile, "create-file", false, "create the configuration file if it doesn't exist")
 }
 
+func addConfigGenerateEndpointsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&rasactlFlags.Config.GenerateEndpoints.Output, "output", "o", "",
+		"path to the endpoints file, the file is printed to stdout if empty")
+	cmd.Flags().StringVar(&rasactlFlags.Config.GenerateEndpoints.CredentialsOutput, "credentials-output", "",
+		"path to the credentials file, the file is not generated if empty")
+	cmd.Flags().StringVar(&rasactlFlags.Config.GenerateEndpoints.Address, "address", types.EndpointsAddressNodePort,
+		"addresses used to reach the deployment services. One of: nodeport|port-forward|cluster-dns")
+}
+
 func enterpriseActivateFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolVar(&rasactlFlags.Enterprise.Activate.LicenseStdin, "license-stdin", false, "read an Enterprise license from stdin")
 	cmd.PersistentFlags().StringVarP(&rasactlFlags.Enterprise.Activate.License, "license", "l", "", "an Enterprise license")
@@ -83,4 +118,122 @@ func logsFlags(cmd *cobra.Command) {
 		"the container in a pod if it exists")
 	cmd.PersistentFlags().Int64Var(&rasactlFlags.Logs.TailLines, "tail", -1, "lines of recent log file to display. Defaults to -1 showing all log lines")
 	cmd.PersistentFlags().StringVarP(&rasactlFlags.Logs.Container, "container", "c", "", "a container name")
+	cmd.PersistentFlags().DurationVar(&rasactlFlags.Logs.Since, "since", 0,
+		"only return logs newer than a relative duration like 5s, 2m, or 3h. Defaults to all logs")
+	cmd.PersistentFlags().BoolVar(&rasactlFlags.Logs.Timestamps, "timestamps", false, "include timestamps on each line in the log output")
+	cmd.PersistentFlags().StringArrayVarP(&rasactlFlags.Logs.Include, "include", "i", nil,
+		"only print log lines that match a regular expression, can be used multiple times")
+	cmd.PersistentFlags().StringArrayVarP(&rasactlFlags.Logs.Exclude, "exclude", "e", nil,
+		"don't print log lines that match a regular expression, can be used multiple times")
+	cmd.PersistentFlags().BoolVarP(&rasactlFlags.Logs.All, "all", "a", false, "stream logs from all pods of the deployment")
+	cmd.PersistentFlags().StringSliceVar(&rasactlFlags.Logs.Components, "component", nil,
+		"stream logs from pods of given components, e.g. rasa-x,rasa-worker,rasa-production,event-service")
+	cmd.PersistentFlags().StringVarP(&rasactlFlags.Logs.Selector, "selector", "l", "", "stream logs from pods that match a label selector")
+	cmd.PersistentFlags().StringVar(&rasactlFlags.Logs.PodQuery, "pod-query", "", "stream logs from pods which names match a regular expression")
+}
+
+func addBackupFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&rasactlFlags.Backup.Output, "output", "o", "",
+		"path to the backup file (default \"<DEPLOYMENT-NAME>-<TIMESTAMP>.tar.gz\" in the current working directory)")
+	cmd.Flags().BoolVar(&rasactlFlags.Backup.SkipModels, "skip-models", false, "don't include models in the backup")
+}
+
+func addRestoreFlags(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&helmConfiguration.Timeout, "wait-timeout", time.Minute*15, "time to wait for Rasa X to be ready")
+	cmd.Flags().StringVar(&helmConfiguration.Version, "rasa-x-chart-version", "",
+		"a helm chart version to use, the version stored in the backup is used if empty")
+	cmd.Flags().BoolVar(&rasactlFlags.Restore.SkipModels, "skip-models", false, "don't restore models from the backup")
+	addPreflightFlags(cmd)
+}
+
+func addApplyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&rasactlFlags.Apply.File, "file", "f", "", "path to the deployment manifest")
+	cmd.Flags().BoolVar(&rasactlFlags.Apply.DryRun, "dry-run", false, "only print changes that would be applied")
+	cmd.Flags().DurationVar(&helmConfiguration.Timeout, "wait-timeout", time.Minute*15, "time to wait for Rasa X to be ready")
+	addPreflightFlags(cmd)
+
+	//nolint:golint,errcheck
+	cmd.MarkFlagRequired("file")
+}
+
+func addRollbackFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&rasactlFlags.Rollback.Revision, "revision", 0, "a revision to roll back to, the previous revision is used if 0")
+	cmd.Flags().DurationVar(&helmConfiguration.Timeout, "wait-timeout", time.Minute*15, "time to wait for Rasa X to be ready")
+}
+
+func addImagesPreloadFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&helmConfiguration.ReleaseName, "rasa-x-release-name", "rasa-x",
+		"a helm release name, used only if the deployment doesn't exist")
+	cmd.Flags().StringVar(&helmConfiguration.Version, "rasa-x-chart-version", "",
+		"a helm chart version to use, the version of the deployment is used if empty")
+	cmd.Flags().StringVar(&rasactlFlags.StartUpgrade.ValuesFile, "values-file", "", "absolute path to the values file")
+	cmd.Flags().StringVar(&rasactlFlags.StartUpgrade.ChartPath, "rasa-x-chart", "",
+		"path to a local rasa-x helm chart, a packaged chart (.tgz) or an unpacked chart directory, the chart repository is not used if set")
+}
+
+func addClusterNameFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&rasactlFlags.Cluster.Name, "name", types.KindClusterName, "name of the kind cluster")
+}
+
+func addClusterCreateFlags(cmd *cobra.Command) {
+	addClusterNameFlag(cmd)
+	cmd.Flags().StringVar(&rasactlFlags.Cluster.Create.KindConfig, "kind-config", "",
+		"absolute path to a kind configuration file, the default configuration is used if empty")
+	cmd.Flags().StringVar(&rasactlFlags.Cluster.Create.Image, "image", "", "node image used by kind, the kind default is used if empty")
+}
+
+func addClusterStatusFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&rasactlFlags.Status.Output, "output", "o", "table",
+		"output format. One of: json|table")
+}
+
+func addActionServerDeployFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&rasactlFlags.ActionServer.Deploy.ProjectPath, "project-path", "",
+		"absolute path to the project directory, the project path of the deployment is used if empty")
+	cmd.Flags().StringVar(&rasactlFlags.ActionServer.Deploy.Image, "image", "",
+		"name of the action server image (default \"rasactl/<DEPLOYMENT-NAME>-actions\")")
+	cmd.Flags().StringVar(&rasactlFlags.ActionServer.Deploy.Tag, "tag", "", "tag of the action server image, a timestamp is used if empty")
+	cmd.Flags().DurationVar(&helmConfiguration.Timeout, "wait-timeout", time.Minute*15, "time to wait for Rasa X to be ready")
+}
+
+func addModelDownloadFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&rasactlFlags.Model.Download.All, "all", false, "download all models to the destination directory")
+	cmd.Flags().StringVar(&rasactlFlags.Model.Download.Tag, "tag", "", "download models with a given tag to the destination directory")
+}
+
+func addModelPruneFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&rasactlFlags.Model.Prune.Keep, "keep", 0, "number of the newest models to keep")
+	cmd.Flags().DurationVar(&rasactlFlags.Model.Prune.OlderThan, "older-than", 0, "delete only models older than a given duration, e.g. 720h")
+	cmd.Flags().StringSliceVar(&rasactlFlags.Model.Prune.KeepTags, "keep-tag", []string{},
+		"never delete models with a given tag, models tagged as 'production' are always kept")
+	cmd.Flags().BoolVar(&rasactlFlags.Model.Prune.DryRun, "dry-run", false, "only print models that would be deleted")
+	cmd.Flags().BoolVar(&rasactlFlags.Model.Prune.Force, "force", false, "don't ask for confirmation")
+}
+
+func addModelWatchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&rasactlFlags.Model.Watch.Tag, "tag", "", "tag uploaded models with a given tag, e.g. production")
+	cmd.Flags().DurationVar(&rasactlFlags.Model.Watch.Debounce, "debounce", time.Second*2,
+		"time to wait for further changes before a model is trained")
+}
+
+func addDebugBundleFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&rasactlFlags.Debug.Bundle.Output, "output", "o", "",
+		"path to the bundle file (default \"<DEPLOYMENT-NAME>-debug-<TIMESTAMP>.tar.gz\" in the current working directory)")
+	cmd.Flags().Int64Var(&rasactlFlags.Debug.Bundle.TailLines, "tail", 1000, "number of recent log lines to collect from each container")
+}
+
+func addDoctorFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&helmConfiguration.ReleaseName, "rasa-x-release-name", "rasa-x",
+		"a helm release name, used only if the deployment doesn't exist")
+	cmd.Flags().StringVar(&helmConfiguration.Version, "rasa-x-chart-version", "",
+		"a helm chart version to use, the version of the deployment is used if empty")
+	cmd.Flags().StringVar(&rasactlFlags.StartUpgrade.ValuesFile, "values-file", "", "absolute path to the values file")
+	cmd.Flags().BoolVarP(&rasactlFlags.Start.Project, "project", "p", false,
+		"run checks for a deployment that uses a local Rasa project")
+}
+
+func addWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&rasactlFlags.Wait.For, "for", types.WaitConditionRunning,
+		fmt.Sprintf("a condition to wait for, one of: %s", strings.Join(types.WaitConditions, ", ")))
+	cmd.Flags().DurationVar(&rasactlFlags.Wait.Timeout, "timeout", time.Minute*10, "time to wait for the condition")
 }
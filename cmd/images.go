@@ -0,0 +1,40 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func imagesCmd() *cobra.Command {
+
+	// cmd represents the images command
+	cmd := &cobra.Command{
+		Use:       "images",
+		Short:     "manage container images used by Rasa X deployments",
+		ValidArgs: []string{"preload"},
+	}
+
+	cmd.AddCommand(imagesPreloadCmd())
+
+	return cmd
+}
+
+func init() {
+
+	imagesCmd := imagesCmd()
+	rootCmd.AddCommand(imagesCmd)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+const (
+	imagesPreloadDesc = `
+Preload images used by a deployment to kind nodes.
+
+The command renders the rasa-x helm chart with the current values of the deployment
+(or with default values if the deployment doesn't exist yet), pulls images used by the chart
+to the local Docker daemon if they are missing, and loads them to the kind control plane
+and to the kind node of the deployment (if it exists).
+
+Images that are already available in a kind node are not loaded again.
+`
+
+	imagesPreloadExample = `
+	# Preload images for the currently active deployment.
+	$ rasactl images preload
+
+	# Preload images for the 'my-deployment' deployment with a custom configuration.
+	$ rasactl images preload my-deployment --values-file custom-configuration.yaml
+`
+)
+
+func imagesPreloadCmd() *cobra.Command {
+
+	// cmd represents the images preload command
+	cmd := &cobra.Command{
+		Use:     "preload [DEPLOYMENT-NAME]",
+		Short:   "preload images used by a deployment to kind nodes",
+		Long:    templates.LongDesc(imagesPreloadDesc),
+		Example: templates.Examples(imagesPreloadExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.CheckHelmChartDir()
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if rasaCtl.KubernetesClient.IsSecretWithStateExist() {
+				stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+				if err != nil {
+					return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+				}
+
+				helmConfiguration.ReleaseName = string(stateData[types.StateHelmReleaseName])
+				if helmConfiguration.Version == "" {
+					helmConfiguration.Version = string(stateData[types.StateHelmChartVersion])
+				}
+			}
+
+			if helmConfiguration.Version == "" {
+				helmConfiguration.Version = types.HelmChartVersionRasaX
+			}
+
+			rasaCtl.HelmClient.SetConfiguration(helmConfiguration)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(helmConfiguration.ReleaseName)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.PreloadImages(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addImagesPreloadFlags(cmd)
+
+	return cmd
+}
@@ -29,6 +29,10 @@ const (
 	logsDesc = `
 Print the logs for a container in a pod. If the pod has only one container, the container name is
 optional.
+
+Logs from multiple pods can be streamed at once by using the --all, --component, --selector
+or --pod-query flags. Each line is prefixed with the pod and container names, and with the --follow flag
+pods that are created later on are streamed as they appear.
 `
 
 	logsExample = `
@@ -49,6 +53,15 @@ optional.
 
 	# Begin streaming the logs from pod rasa-x
   $ rasactl logs -f rasa-x
+
+	# Stream the logs from all pods of the rasa-x, rasa-worker and rasa-production components.
+	$ rasactl logs -f --component rasa-x,rasa-worker,rasa-production
+
+	# Stream the logs from all pods from the last 10 minutes, skip health check requests.
+	$ rasactl logs -f --all --since 10m --exclude '/health'
+
+	# Print errors from pods which names match a regular expression.
+	$ rasactl logs --pod-query 'rasa-(worker|production)' --include 'ERROR'
 `
 )
 
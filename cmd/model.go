@@ -25,7 +25,7 @@ func modelCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:       "model",
 		Short:     "manage models for Rasa X / Enterprise",
-		ValidArgs: []string{"delete", "download", "list", "tag", "upload"},
+		ValidArgs: []string{"delete", "download", "list", "prune", "tag", "upload", "watch"},
 	}
 
 	cmd.AddCommand(modelUploadCmd())
@@ -33,6 +33,8 @@ func modelCmd() *cobra.Command {
 	cmd.AddCommand(modelDownloadCmd())
 	cmd.AddCommand(modelTagCmd())
 	cmd.AddCommand(modelDeleteCmd())
+	cmd.AddCommand(modelPruneCmd())
+	cmd.AddCommand(modelWatchCmd())
 
 	return cmd
 }
@@ -28,6 +28,13 @@ import (
 const (
 	modelDownloadDesc = `
 Download a model from Rasa X / Enterprise to your local machine.
+
+The downloaded file is verified against the model hash reported by Rasa X.
+If a download is interrupted, it's resumed from the point where it stopped,
+also when the command is run again with the same destination.
+
+Use the --all flag to download all models, or the --tag flag to download models
+with a given tag. In this case, the destination is a directory.
 `
 
 	modelDownloadExample = `
@@ -38,34 +45,49 @@ Download a model from Rasa X / Enterprise to your local machine.
 	# Download the 'example-model' model for the 'my-deployment' deployment
 	# and store it in the /tmp directory.
 	$ rasactl model download my-deployment example-model /tmp/example-model.tar.gz
+
+	# Download all models tagged as 'production' to the /tmp/models directory.
+	$ rasactl model download --tag production /tmp/models
 `
 )
 
 func modelDownloadCmd() *cobra.Command {
 	// cmd represents the model download command
 	cmd := &cobra.Command{
-		Use:     "download [DEPLOYMENT-NAME] MODEL-NAME [DESTINATION]",
+		Use:     "download [DEPLOYMENT-NAME] [MODEL-NAME] [DESTINATION]",
 		Short:   "download a model from Rasa X / Enterprise",
 		Long:    templates.LongDesc(modelDownloadDesc),
 		Example: templates.Examples(modelDownloadExample),
-		Args:    cobra.RangeArgs(1, 3),
+		Args:    cobra.MaximumNArgs(3),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			if err := checkIfDeploymentsExist(); err != nil {
 				return err
 			}
 
-			args, err := parseArgs(namespace, args, 1, 3, rasactlFlags)
-			if err != nil {
-				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			if rasactlFlags.Model.Download.All || rasactlFlags.Model.Download.Tag != "" {
+				// Download multiple models, only a destination directory can be passed.
+				args, err := parseArgs(namespace, args, 1, 2, rasactlFlags)
+				if err != nil {
+					return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+				}
+				rasactlFlags.Model.Download.FilePath = args[1]
+			} else {
+				if len(args) == 0 {
+					return xerrors.Errorf(errorPrint.Sprintf("A model name is required, or use the --all or --tag flag"))
+				}
+
+				args, err := parseArgs(namespace, args, 1, 3, rasactlFlags)
+				if err != nil {
+					return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+				}
+				rasactlFlags.Model.Download.Name = args[1]
+				rasactlFlags.Model.Download.FilePath = args[2]
 			}
 
 			if err := checkIfNamespaceExists(); err != nil {
 				return err
 			}
 
-			rasactlFlags.Model.Download.Name = args[1]
-			rasactlFlags.Model.Download.FilePath = args[2]
-
 			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
 			if err != nil {
 				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
@@ -104,5 +126,7 @@ func modelDownloadCmd() *cobra.Command {
 		},
 	}
 
+	addModelDownloadFlags(cmd)
+
 	return cmd
 }
@@ -0,0 +1,114 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	modelPruneDesc = `
+Delete models from Rasa X / Enterprise by retention rules.
+
+A model is deleted only if none of the rules keeps it:
+  - the --keep flag keeps the N newest models,
+  - the --older-than flag keeps models that are not older than a given duration,
+  - the --keep-tag flag keeps models with a given tag, models tagged as 'production' are always kept.
+
+The command prints a table with models that are kept and deleted, and asks for confirmation before
+models are deleted. Use the --dry-run flag to only print the table.
+`
+
+	modelPruneExample = `
+	# Keep the 10 newest models, and models tagged as 'production' (use the currently active deployment).
+	$ rasactl model prune --keep 10
+
+	# Show models older than 30 days that would be deleted for the 'my-deployment' deployment.
+	$ rasactl model prune my-deployment --older-than 720h --dry-run
+
+	# Delete models older than 7 days, keep models tagged as 'production' or 'staging', don't ask for confirmation.
+	$ rasactl model prune --older-than 168h --keep-tag staging --force
+`
+)
+
+func modelPruneCmd() *cobra.Command {
+	// cmd represents the model prune command
+	cmd := &cobra.Command{
+		Use:     "prune [DEPLOYMENT-NAME]",
+		Short:   "delete models from Rasa X / Enterprise by retention rules",
+		Long:    templates.LongDesc(modelPruneDesc),
+		Example: templates.Examples(modelPruneExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+			rasaCtl.HelmClient.SetConfiguration(
+				&types.HelmConfigurationSpec{
+					ReleaseName: string(stateData[types.StateHelmReleaseName]),
+				},
+			)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(string(stateData[types.StateHelmReleaseName]))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Check if a Rasa X deployment is running
+			_, isRunning, err := rasaCtl.CheckDeploymentStatus()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if !isRunning {
+				fmt.Printf("The %s deployment is not running.\n", rasaCtl.Namespace)
+				return nil
+			}
+
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			if err := rasaCtl.ModelPrune(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addModelPruneFlags(cmd)
+
+	return cmd
+}
@@ -28,6 +28,9 @@ import (
 const (
 	modelUploadDesc = `
 Upload a model to Rasa X / Enterprise.
+
+The model file is streamed from disk, and once the upload is finished,
+the checksum of the file is verified against the model hash reported by Rasa X.
 `
 
 	modelUploadExample = `
@@ -0,0 +1,119 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+const (
+	modelWatchDesc = `
+Watch the project directory and train and upload a model on changes.
+
+The command works for deployments started with the --project flag. It watches the 'data' directory,
+and the 'domain.yml' and 'config.yml' files in the project directory. If they change, a model is
+trained with the 'rasa train' command and uploaded to Rasa X / Enterprise.
+Use the --tag flag to tag each uploaded model, e.g. as 'production'.
+
+The command requires the 'rasa' command to be installed locally.
+`
+
+	modelWatchExample = `
+	# Watch the project directory of the currently active deployment.
+	$ rasactl model watch
+
+	# Watch the project directory of the 'my-deployment' deployment and tag uploaded models as 'production'.
+	$ rasactl model watch my-deployment --tag production
+`
+)
+
+func modelWatchCmd() *cobra.Command {
+	// cmd represents the model watch command
+	cmd := &cobra.Command{
+		Use:     "watch [DEPLOYMENT-NAME]",
+		Short:   "train and upload a model on changes in the project directory",
+		Long:    templates.LongDesc(modelWatchDesc),
+		Example: templates.Examples(modelWatchExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !utils.CommandExists("rasa") {
+				return xerrors.Errorf(
+					errorPrint.Sprint(
+						"The 'rasa' command doesn't exist. Check out the docs to learn how to install rasa, https://rasa.com/docs/rasa/installation/",
+					),
+				)
+			}
+
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+			rasaCtl.HelmClient.SetConfiguration(
+				&types.HelmConfigurationSpec{
+					ReleaseName: string(stateData[types.StateHelmReleaseName]),
+				},
+			)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(string(stateData[types.StateHelmReleaseName]))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Check if a Rasa X deployment is running
+			_, isRunning, err := rasaCtl.CheckDeploymentStatus()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if !isRunning {
+				fmt.Printf("The %s deployment is not running.\n", rasaCtl.Namespace)
+				return nil
+			}
+
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			if err := rasaCtl.ModelWatch(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addModelWatchFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+const (
+	restoreDesc = `
+Restore a Rasa X deployment from a backup created by the 'rasactl backup' command.
+
+If the deployment doesn't exist, it's created with the helm values and the rasactl state stored in the backup,
+the project directory recorded in the backup is used if it exists. An existing deployment keeps its helm release.
+The PostgreSQL database and models are restored afterwards.
+
+The backup can be restored to the same or to another deployment.
+`
+
+	restoreExample = `
+	# Restore the currently active deployment from the my-deployment.tar.gz backup.
+	$ rasactl restore my-deployment.tar.gz
+
+	# Restore the backup to the 'my-deployment-copy' deployment.
+	# The deployment is created if it doesn't exist.
+	$ rasactl restore my-deployment-copy my-deployment.tar.gz
+`
+)
+
+func restoreCmd() *cobra.Command {
+
+	// cmd represents the restore command
+	cmd := &cobra.Command{
+		Use:     "restore [DEPLOYMENT-NAME] BACKUP-FILE",
+		Short:   "restore Rasa X deployment from a backup",
+		Long:    templates.LongDesc(restoreDesc),
+		Example: templates.Examples(restoreExample),
+		Args:    cobra.RangeArgs(1, 2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.CheckHelmChartDir()
+
+			// The last argument is always a backup file, the deployment
+			// doesn't have to exist, so it's parsed separately.
+			rasactlFlags.Restore.File = args[len(args)-1]
+			if _, err := parseArgs(namespace, args[:len(args)-1], 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			rasaCtl.HelmClient.SetConfiguration(helmConfiguration)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.Restore(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addRestoreFlags(cmd)
+
+	return cmd
+}
+
+func init() {
+
+	restoreCmd := restoreCmd()
+	rootCmd.AddCommand(restoreCmd)
+}
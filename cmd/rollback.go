@@ -0,0 +1,116 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	rollbackDesc = `
+Roll back a deployment to a previous revision.
+
+By default, the deployment is rolled back to the previous revision,
+use the --revision flag to roll back to a specific revision.
+Available revisions can be listed by the 'rasactl history' command.
+A deployment stopped with 'rasactl stop' stays stopped after it's rolled back.
+`
+
+	rollbackExample = `
+	# Roll back the currently active deployment to the previous revision.
+	$ rasactl rollback
+
+	# Roll back the 'my-deployment' deployment to the revision 2.
+	$ rasactl rollback my-deployment --revision 2
+`
+)
+
+func rollbackCmd() *cobra.Command {
+
+	// cmd represents the rollback command
+	cmd := &cobra.Command{
+		Use:     "rollback [DEPLOYMENT-NAME]",
+		Short:   "roll back Rasa X deployment to a previous revision",
+		Long:    templates.LongDesc(rollbackDesc),
+		Example: templates.Examples(rollbackExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			if rasactlFlags.Rollback.Revision < 0 {
+				return xerrors.Errorf(errorPrint.Sprintf("The revision can't be negative"))
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			helmConfiguration.ReleaseName = string(stateData[types.StateHelmReleaseName])
+			rasaCtl.HelmClient.SetConfiguration(helmConfiguration)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(helmConfiguration.ReleaseName)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// A deployment that is not running can be rolled back as well,
+			// e.g. if the current revision has crash looping pods. A stopped deployment stays stopped.
+			isDeployed, _, err := rasaCtl.CheckDeploymentStatus()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if !isDeployed {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s deployment doesn't have a Rasa X release, can't roll it back", rasaCtl.Namespace))
+			}
+
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.Rollback(); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	addRollbackFlags(cmd)
+
+	return cmd
+}
+
+func init() {
+
+	rollbackCmd := rollbackCmd()
+	rootCmd.AddCommand(rollbackCmd)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	scaleDesc = `
+Set the number of replicas for a component of a deployment.
+
+A component is a name of a deployment or a statefulset without the helm release name prefix
+(e.g. rasa-x, rasa-production, rasa-worker, nginx, postgresql), or a value of the 'app.kubernetes.io/component' label.
+
+The replica count is stored in the deployment state and it's restored when the deployment is started again.
+If the deployment is stopped, the replica count is applied the next time it's started.
+`
+
+	scaleExample = `
+	# Run 2 replicas of the rasa-production component for the currently active deployment.
+	$ rasactl scale rasa-production 2
+
+	# Run 3 replicas of the rasa-worker component for the 'my-deployment' deployment.
+	$ rasactl scale my-deployment rasa-worker 3
+`
+)
+
+func scaleCmd() *cobra.Command {
+	var component string
+	var replicas int32
+
+	// cmd represents the scale command
+	cmd := &cobra.Command{
+		Use:     "scale [DEPLOYMENT-NAME] COMPONENT REPLICAS",
+		Short:   "set the number of replicas for a component",
+		Long:    templates.LongDesc(scaleDesc),
+		Example: templates.Examples(scaleExample),
+		Args:    cobra.RangeArgs(2, 3),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkIfDeploymentsExist(); err != nil {
+				return err
+			}
+
+			// The last two arguments are always a component and a replica count.
+			component = args[len(args)-2]
+			count, err := strconv.ParseInt(args[len(args)-1], 10, 32)
+			if err != nil || count < 0 {
+				return xerrors.Errorf(errorPrint.Sprintf("The number of replicas has to be a non-negative integer, got %q", args[len(args)-1]))
+			}
+			replicas = int32(count)
+
+			if _, err := parseArgs(namespace, args[:len(args)-2], 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return err
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+			rasaCtl.HelmClient.SetConfiguration(
+				&types.HelmConfigurationSpec{
+					ReleaseName: string(stateData[types.StateHelmReleaseName]),
+				},
+			)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(string(stateData[types.StateHelmReleaseName]))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.Scale(component, replicas); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func init() {
+
+	scaleCmd := scaleCmd()
+	rootCmd.AddCommand(scaleCmd)
+}
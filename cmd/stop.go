@@ -79,13 +79,14 @@ func stopCmd() *cobra.Command {
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Check if a Rasa X deployment is already installed and running
-			_, isRunning, err := rasaCtl.CheckDeploymentStatus()
+			// A deployment with pods that are not ready is stopped as well,
+			// so that replica counts of all its components are recorded.
+			isStopped, err := rasaCtl.KubernetesClient.IsScaledDown()
 			if err != nil {
 				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
 			}
 
-			if !isRunning {
+			if isStopped {
 				fmt.Printf("The %s deployment is not running.\n", rasaCtl.Namespace)
 				return nil
 			}
@@ -34,11 +34,18 @@ The upgrade command upgrades or changes configuration for Rasa X / Enterprise de
 
 You can specify a values file with you custom configuration. The values file has the same form as a values file for helm chart.
 Here you can find all available values that can be configured: https://github.com/RasaHQ/rasa-x-helm/blob/main/charts/rasa-x/values.yaml
+
+Use the --dry-run flag to see which resources the upgrade changes without applying it,
+and the --diff flag to print a diff between the deployed and the upgraded manifests and values.
+Sensitive values, e.g. passwords, tokens and data of secrets, are redacted in the diff.
 `
 
 	upgradeExample = `
 	# Change configuration for Rasa X / Enterprise deployment by passing a custom configuration.
 	$ rasactl upgrade my-deployment --values-file my-custom-values.yaml
+
+	# Show changes that the upgrade would make without applying them.
+	$ rasactl upgrade my-deployment --values-file my-custom-values.yaml --dry-run --diff
 `
 )
 
@@ -53,6 +60,10 @@ func upgradeCmd() *cobra.Command {
 		Args:    cobra.MaximumNArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			utils.CheckHelmChartDir()
+			if rasactlFlags.Upgrade.Diff && !rasactlFlags.Upgrade.DryRun {
+				return xerrors.Errorf(errorPrint.Sprintf("The --diff flag can be used only together with the --dry-run flag"))
+			}
+
 			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
 				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
 			}
@@ -88,6 +99,14 @@ func upgradeCmd() *cobra.Command {
 				return nil
 			}
 
+			if rasactlFlags.Upgrade.DryRun {
+				defer rasaCtl.Spinner.Stop()
+				if err := rasaCtl.UpgradeDryRun(); err != nil {
+					return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+				}
+				return nil
+			}
+
 			if err := rasaCtl.Upgrade(); err != nil {
 				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
 			}
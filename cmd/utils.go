@@ -10,12 +10,18 @@ import (
 	"golang.org/x/xerrors"
 
 	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
 )
 
 // HandleSignals receives a signal from the channel and runs an action depends on the type of the signal.
+// Signals are ignored while a command handles them on its own, see utils.NotifySignals.
 func HandleSignals(sigs chan os.Signal) {
-	signal := <-sigs
-	runOnClose(signal)
+	for signal := range sigs {
+		if utils.IsSignalHandledLocally() {
+			continue
+		}
+		runOnClose(signal)
+	}
 }
 
 func runOnClose(signal os.Signal) {
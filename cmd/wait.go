@@ -0,0 +1,120 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	waitDesc = `
+Wait until a deployment reaches a given condition.
+
+Supported conditions, each condition includes the previous ones:
+
+* running - all components of the deployment are running
+* migrated - the Rasa X database migration is completed
+* production - Rasa Server in the production environment is ready
+* model - a model tagged 'production' exists and is loaded by the production Rasa Server,
+  the command requires credentials (see 'rasactl auth login')
+
+The command exits with one of the following codes, so that it can be used in CI pipelines:
+
+* 0 - the condition has been reached
+* 1 - an error has occurred
+* 2 - the condition hasn't been reached before the timeout
+* 3 - the deployment doesn't exist
+* 4 - the deployment is unhealthy, e.g. the helm release has failed or pods can't start
+`
+
+	waitExample = `
+	# Wait until the currently active deployment is running.
+	$ rasactl wait
+
+	# Wait up to 20 minutes until the 'my-deployment' deployment has Rasa Server connected to the production environment.
+	$ rasactl wait my-deployment --for production --timeout 20m
+
+	# Wait for a production model and check the exit code.
+	$ rasactl wait --for model || echo "exit code: $?"
+`
+)
+
+func waitCmd() *cobra.Command {
+
+	// cmd represents the wait command
+	cmd := &cobra.Command{
+		Use:     "wait [DEPLOYMENT-NAME]",
+		Short:   "wait until a deployment reaches a condition",
+		Long:    templates.LongDesc(waitDesc),
+		Example: templates.Examples(waitExample),
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkIfDeploymentsExist(); err != nil {
+				return &types.ExitError{Code: types.ExitCodeNotFound, Err: err}
+			}
+
+			if _, err := parseArgs(namespace, args, 1, 1, rasactlFlags); err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+
+			if err := checkIfNamespaceExists(); err != nil {
+				return &types.ExitError{Code: types.ExitCodeNotFound, Err: err}
+			}
+
+			stateData, err := rasaCtl.KubernetesClient.ReadSecretWithState()
+			if err != nil {
+				return xerrors.Errorf(errorPrint.Sprintf("%s", err))
+			}
+			rasaCtl.HelmClient.SetConfiguration(
+				&types.HelmConfigurationSpec{
+					ReleaseName: string(stateData[types.StateHelmReleaseName]),
+				},
+			)
+			rasaCtl.KubernetesClient.SetHelmReleaseName(string(stateData[types.StateHelmReleaseName]))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !rasaCtl.KubernetesClient.IsNamespaceManageable() {
+				return xerrors.Errorf(errorPrint.Sprintf("The %s namespace exists but is not managed by rasactl, can't continue :(", rasaCtl.Namespace))
+			}
+
+			defer rasaCtl.Spinner.Stop()
+			if err := rasaCtl.Wait(); err != nil {
+				return &types.ExitError{
+					Code: types.ExitCode(err),
+					Err:  xerrors.Errorf(errorPrint.Sprintf("%s", err)),
+				}
+			}
+
+			return nil
+		},
+	}
+
+	addWaitFlags(cmd)
+
+	return cmd
+}
+
+func init() {
+
+	waitCmd := waitCmd()
+	rootCmd.AddCommand(waitCmd)
+}
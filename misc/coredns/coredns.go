@@ -0,0 +1,27 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package coredns provides the configuration of the CoreDNS rasactl.localhost zone.
+package coredns
+
+import (
+	_ "embed"
+)
+
+// ZoneFile is the zone file for the rasactl.localhost domain, the same file is used
+// by the rasa/rasactl:coredns image.
+//
+//go:embed rasactl.localhost
+var ZoneFile string
@@ -5,3 +5,6 @@ import (
 )
 
 var Helper = osxkeychain.Osxkeychain{}
+
+// SetupHint describes how to set up the credential helper.
+var SetupHint = "make sure that the login keychain exists and is unlocked"
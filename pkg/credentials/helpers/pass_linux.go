@@ -5,3 +5,6 @@ import (
 )
 
 var Helper = pass.Pass{}
+
+// SetupHint describes how to set up the credential helper.
+var SetupHint = "install pass and initialize a password store with 'pass init <GPG-KEY-ID>'"
@@ -5,3 +5,6 @@ import (
 )
 
 var Helper = wincred.Wincred{}
+
+// SetupHint describes how to set up the credential helper.
+var SetupHint = "make sure that the Windows Credential Manager is available for the current user"
@@ -0,0 +1,106 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package docker
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/archive"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"golang.org/x/xerrors"
+)
+
+// BuildImage builds an image from a given build context directory and tags it with a given tag.
+// The dockerfile path is relative to the build context directory.
+func (d *Docker) BuildImage(contextDir, dockerfile, tag string) error {
+	excludes, err := readDockerignore(contextDir)
+	if err != nil {
+		return err
+	}
+
+	buildContext, err := archive.TarWithOptions(contextDir, &archive.TarOptions{ExcludePatterns: excludes})
+	if err != nil {
+		return xerrors.Errorf("can't prepare the build context: %w", err)
+	}
+	defer buildContext.Close()
+
+	d.Log.Info("Building image", "context", contextDir, "dockerfile", dockerfile, "tag", tag)
+	resp, err := d.Client.ImageBuild(d.Ctx, buildContext, types.ImageBuildOptions{
+		Dockerfile: filepath.ToSlash(dockerfile),
+		Tags:       []string{tag},
+		Remove:     true,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var msg jsonmessage.JSONMessage
+		if err := decoder.Decode(&msg); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if msg.Error != nil {
+			return xerrors.Errorf("can't build the %s image: %s", tag, msg.Error.Message)
+		}
+
+		if stream := strings.TrimSpace(msg.Stream); stream != "" {
+			d.Log.V(1).Info("Building image", "details", stream)
+		}
+	}
+
+	return nil
+}
+
+// readDockerignore returns exclude patterns defined in the .dockerignore file of a build context directory.
+func readDockerignore(contextDir string) ([]string, error) {
+	file, err := os.Open(filepath.Join(contextDir, ".dockerignore"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	excludes := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		pattern := strings.TrimSpace(scanner.Text())
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+
+		invert := strings.HasPrefix(pattern, "!")
+		pattern = filepath.Clean(strings.TrimPrefix(pattern, "!"))
+		if invert {
+			pattern = "!" + pattern
+		}
+		excludes = append(excludes, pattern)
+	}
+
+	return excludes, scanner.Err()
+}
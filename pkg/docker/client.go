@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -53,6 +54,15 @@ type Interface interface {
 	SetProjectPath(path string)
 	GetKindNetworkGatewayAddress() (string, error)
 	GetServerVersion() (string, error)
+	PullImage(image string) (bool, error)
+	GetKindNodeImages(node string) ([]string, error)
+	LoadImagesToKindNode(node string, images []string) error
+	IsKindNodeExist(hostname string) (bool, error)
+	BuildImage(contextDir, dockerfile, tag string) error
+	RunRasaServer(spec RasaServerSpec) error
+	StreamContainerLogs(name string, stdout, stderr io.Writer) error
+	DeleteContainer(name string) error
+	DeleteRasaServerContainers() error
 }
 
 // Docker represents a Docker client.
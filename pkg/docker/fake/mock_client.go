@@ -5,6 +5,7 @@
 package fake
 
 import (
+	io "io"
 	reflect "reflect"
 
 	docker "github.com/RasaHQ/rasactl/pkg/docker"
@@ -35,6 +36,20 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// BuildImage mocks base method.
+func (m *MockInterface) BuildImage(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildImage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuildImage indicates an expected call of BuildImage.
+func (mr *MockInterfaceMockRecorder) BuildImage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildImage", reflect.TypeOf((*MockInterface)(nil).BuildImage), arg0, arg1, arg2)
+}
+
 // CreateKindNode mocks base method.
 func (m *MockInterface) CreateKindNode(arg0 string) (container.ContainerCreateCreatedBody, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +65,20 @@ func (mr *MockInterfaceMockRecorder) CreateKindNode(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKindNode", reflect.TypeOf((*MockInterface)(nil).CreateKindNode), arg0)
 }
 
+// DeleteContainer mocks base method.
+func (m *MockInterface) DeleteContainer(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContainer", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContainer indicates an expected call of DeleteContainer.
+func (mr *MockInterfaceMockRecorder) DeleteContainer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContainer", reflect.TypeOf((*MockInterface)(nil).DeleteContainer), arg0)
+}
+
 // DeleteKindNode mocks base method.
 func (m *MockInterface) DeleteKindNode(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -64,6 +93,20 @@ func (mr *MockInterfaceMockRecorder) DeleteKindNode(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKindNode", reflect.TypeOf((*MockInterface)(nil).DeleteKindNode), arg0)
 }
 
+// DeleteRasaServerContainers mocks base method.
+func (m *MockInterface) DeleteRasaServerContainers() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRasaServerContainers")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRasaServerContainers indicates an expected call of DeleteRasaServerContainers.
+func (mr *MockInterfaceMockRecorder) DeleteRasaServerContainers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRasaServerContainers", reflect.TypeOf((*MockInterface)(nil).DeleteRasaServerContainers))
+}
+
 // GetKind mocks base method.
 func (m *MockInterface) GetKind() docker.KindSpec {
 	m.ctrl.T.Helper()
@@ -93,6 +136,21 @@ func (mr *MockInterfaceMockRecorder) GetKindNetworkGatewayAddress() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKindNetworkGatewayAddress", reflect.TypeOf((*MockInterface)(nil).GetKindNetworkGatewayAddress))
 }
 
+// GetKindNodeImages mocks base method.
+func (m *MockInterface) GetKindNodeImages(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKindNodeImages", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKindNodeImages indicates an expected call of GetKindNodeImages.
+func (mr *MockInterfaceMockRecorder) GetKindNodeImages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKindNodeImages", reflect.TypeOf((*MockInterface)(nil).GetKindNodeImages), arg0)
+}
+
 // GetServerVersion mocks base method.
 func (m *MockInterface) GetServerVersion() (string, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +166,64 @@ func (mr *MockInterfaceMockRecorder) GetServerVersion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerVersion", reflect.TypeOf((*MockInterface)(nil).GetServerVersion))
 }
 
+// IsKindNodeExist mocks base method.
+func (m *MockInterface) IsKindNodeExist(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsKindNodeExist", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsKindNodeExist indicates an expected call of IsKindNodeExist.
+func (mr *MockInterfaceMockRecorder) IsKindNodeExist(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsKindNodeExist", reflect.TypeOf((*MockInterface)(nil).IsKindNodeExist), arg0)
+}
+
+// LoadImagesToKindNode mocks base method.
+func (m *MockInterface) LoadImagesToKindNode(arg0 string, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadImagesToKindNode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadImagesToKindNode indicates an expected call of LoadImagesToKindNode.
+func (mr *MockInterfaceMockRecorder) LoadImagesToKindNode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadImagesToKindNode", reflect.TypeOf((*MockInterface)(nil).LoadImagesToKindNode), arg0, arg1)
+}
+
+// PullImage mocks base method.
+func (m *MockInterface) PullImage(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullImage", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PullImage indicates an expected call of PullImage.
+func (mr *MockInterfaceMockRecorder) PullImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullImage", reflect.TypeOf((*MockInterface)(nil).PullImage), arg0)
+}
+
+// RunRasaServer mocks base method.
+func (m *MockInterface) RunRasaServer(arg0 docker.RasaServerSpec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunRasaServer", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunRasaServer indicates an expected call of RunRasaServer.
+func (mr *MockInterfaceMockRecorder) RunRasaServer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRasaServer", reflect.TypeOf((*MockInterface)(nil).RunRasaServer), arg0)
+}
+
 // SetKind mocks base method.
 func (m *MockInterface) SetKind(arg0 docker.KindSpec) {
 	m.ctrl.T.Helper()
@@ -171,3 +287,17 @@ func (mr *MockInterfaceMockRecorder) StopKindNode(arg0 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopKindNode", reflect.TypeOf((*MockInterface)(nil).StopKindNode), arg0)
 }
+
+// StreamContainerLogs mocks base method.
+func (m *MockInterface) StreamContainerLogs(arg0 string, arg1, arg2 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamContainerLogs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamContainerLogs indicates an expected call of StreamContainerLogs.
+func (mr *MockInterfaceMockRecorder) StreamContainerLogs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamContainerLogs", reflect.TypeOf((*MockInterface)(nil).StreamContainerLogs), arg0, arg1, arg2)
+}
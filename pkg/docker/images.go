@@ -0,0 +1,161 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package docker
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/docker/docker/pkg/stdcopy"
+	"golang.org/x/xerrors"
+)
+
+// PullImage pulls an image to the local Docker daemon if the image doesn't exist.
+// It returns 'true' if the image has been pulled.
+func (d *Docker) PullImage(image string) (bool, error) {
+	if _, _, err := d.Client.ImageInspectWithRaw(d.Ctx, image); err == nil {
+		return false, nil
+	} else if !client.IsErrNotFound(err) {
+		return false, err
+	}
+
+	d.Log.Info("Pulling image", "image", image)
+	imagePull, err := d.Client.ImagePull(d.Ctx, image, types.ImagePullOptions{})
+	if err != nil {
+		return false, err
+	}
+	defer imagePull.Close()
+
+	// The stream contains an error message if the pull has failed,
+	// e.g. if the image doesn't exist or the registry is not accessible.
+	var details bytes.Buffer
+	err = jsonmessage.DisplayJSONMessagesStream(imagePull, &details, 0, false, nil)
+	d.Log.V(1).Info("Pulling image", "image", image, "details", strings.TrimSpace(details.String()))
+	if err != nil {
+		return false, xerrors.Errorf("can't pull the %s image: %w", image, err)
+	}
+
+	return true, nil
+}
+
+// GetKindNodeImages returns normalized names of images available in a kind node.
+func (d *Docker) GetKindNodeImages(node string) ([]string, error) {
+	stdout, err := d.execInContainer(node, []string{"ctr", "--namespace=k8s.io", "images", "list", "-q"}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	images := []string{}
+	for _, image := range strings.Split(stdout, "\n") {
+		if image = strings.TrimSpace(image); image != "" && !strings.HasPrefix(image, "sha256:") {
+			images = append(images, image)
+		}
+	}
+
+	return images, nil
+}
+
+// LoadImagesToKindNode loads images from the local Docker daemon to a kind node.
+func (d *Docker) LoadImagesToKindNode(node string, images []string) error {
+	d.Log.Info("Loading images to a kind node", "node", node, "images", images)
+
+	imageSave, err := d.Client.ImageSave(d.Ctx, images)
+	if err != nil {
+		return err
+	}
+	defer imageSave.Close()
+
+	_, err = d.execInContainer(node,
+		[]string{"ctr", "--namespace=k8s.io", "images", "import", "--all-platforms", "--digests", "--snapshotter=overlayfs", "-"},
+		imageSave,
+	)
+	if err != nil {
+		return xerrors.Errorf("can't load images to the %s kind node: %w", node, err)
+	}
+
+	return nil
+}
+
+// IsKindNodeExist checks if a container that is used as a kind node exists.
+func (d *Docker) IsKindNodeExist(hostname string) (bool, error) {
+	if _, err := d.Client.ContainerInspect(d.Ctx, hostname); err != nil {
+		if client.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// execInContainer executes a command in a container and returns the command output.
+// If stdin is not nil, it's passed to the command.
+func (d *Docker) execInContainer(container string, command []string, stdin io.Reader) (string, error) {
+	execSpec, err := d.Client.ContainerExecCreate(d.Ctx, container, types.ExecConfig{
+		WorkingDir:   "/",
+		Cmd:          command,
+		AttachStdin:  stdin != nil,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := d.Client.ContainerExecAttach(d.Ctx, execSpec.ID, types.ExecStartCheck{})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Close()
+
+	stdinErr := make(chan error, 1)
+	if stdin != nil {
+		go func() {
+			_, err := io.Copy(resp.Conn, stdin)
+			if err == nil {
+				err = resp.CloseWrite()
+			}
+			stdinErr <- err
+		}()
+	} else {
+		stdinErr <- nil
+	}
+
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	if _, err := stdcopy.StdCopy(stdout, stderr, resp.Reader); err != nil {
+		return "", err
+	}
+
+	if err := <-stdinErr; err != nil {
+		return "", err
+	}
+
+	inspect, err := d.Client.ContainerExecInspect(d.Ctx, execSpec.ID)
+	if err != nil {
+		return "", err
+	}
+
+	if inspect.ExitCode != 0 {
+		return "", xerrors.Errorf("command %q exited with code %d: %s",
+			strings.Join(command, " "), inspect.ExitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package docker
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
+)
+
+// RasaServerSpec stores specification for a container that runs a Rasa server.
+type RasaServerSpec struct {
+	// Name is the container name.
+	Name string
+	// Image is the Rasa image, e.g. rasa/rasa:2.8.1.
+	Image string
+	// Args are arguments passed to the 'rasa' command.
+	Args []string
+	// Port is the port the Rasa server listens on, the same port is published on the host.
+	Port int
+	// Mounts is a list of local directories mounted into the container under the same path.
+	Mounts []string
+	// HostNetwork specifies if the container uses the host network.
+	HostNetwork bool
+}
+
+// RunRasaServer creates and starts a container that runs a Rasa server.
+// An existing container with the same name is replaced.
+func (d *Docker) RunRasaServer(spec RasaServerSpec) error {
+	if _, err := d.PullImage(spec.Image); err != nil {
+		return err
+	}
+
+	if err := d.DeleteContainer(spec.Name); err != nil {
+		return err
+	}
+
+	port := nat.Port(fmt.Sprintf("%d/tcp", spec.Port))
+	hostConfig := &container.HostConfig{
+		ExtraHosts: []string{"host.docker.internal:host-gateway"},
+	}
+
+	if spec.HostNetwork {
+		hostConfig.NetworkMode = "host"
+		// The host.docker.internal alias is not supported with the host network.
+		hostConfig.ExtraHosts = nil
+	} else {
+		hostConfig.PortBindings = nat.PortMap{
+			port: []nat.PortBinding{{HostPort: fmt.Sprintf("%d", spec.Port)}},
+		}
+	}
+
+	for _, dir := range spec.Mounts {
+		hostConfig.Mounts = append(hostConfig.Mounts, mount.Mount{
+			Source:   dir,
+			Target:   dir,
+			Type:     mount.TypeBind,
+			ReadOnly: true,
+		})
+	}
+
+	d.Log.Info("Creating a Rasa server container", "name", spec.Name, "image", spec.Image)
+	resp, err := d.Client.ContainerCreate(d.Ctx,
+		&container.Config{
+			Image:        spec.Image,
+			Cmd:          spec.Args,
+			ExposedPorts: nat.PortSet{port: struct{}{}},
+			Labels: map[string]string{
+				"rasactl":           "true",
+				"rasactl.namespace": d.Namespace,
+			},
+		},
+		hostConfig, nil, nil, spec.Name,
+	)
+	if err != nil {
+		return err
+	}
+
+	return d.Client.ContainerStart(d.Ctx, resp.ID, types.ContainerStartOptions{})
+}
+
+// StreamContainerLogs streams logs of a container to stdout and stderr.
+// The function blocks until the container stops.
+func (d *Docker) StreamContainerLogs(name string, stdout, stderr io.Writer) error {
+	logs, err := d.Client.ContainerLogs(d.Ctx, name, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+	})
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	_, err = stdcopy.StdCopy(stdout, stderr, logs)
+	return err
+}
+
+// DeleteContainer stops and deletes a container if it exists.
+func (d *Docker) DeleteContainer(name string) error {
+	timeout := time.Second * 10
+	if err := d.Client.ContainerStop(d.Ctx, name, &timeout); err != nil {
+		if client.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return d.Client.ContainerRemove(d.Ctx, name, types.ContainerRemoveOptions{
+		RemoveVolumes: true,
+		Force:         true,
+	})
+}
+
+// DeleteRasaServerContainers deletes Rasa server containers created for the namespace,
+// e.g. containers left by a previous run that has been killed.
+func (d *Docker) DeleteRasaServerContainers() error {
+	containers, err := d.Client.ContainerList(d.Ctx, types.ContainerListOptions{
+		All: true,
+		Filters: filters.NewArgs(
+			filters.Arg("label", "rasactl=true"),
+			filters.Arg("label", fmt.Sprintf("rasactl.namespace=%s", d.Namespace)),
+		),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range containers {
+		d.Log.Info("Deleting a stale Rasa server container", "id", c.ID, "names", c.Names)
+		if err := d.DeleteContainer(c.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
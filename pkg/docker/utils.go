@@ -17,6 +17,7 @@ package docker
 
 import (
 	"github.com/Masterminds/semver/v3"
+	"github.com/docker/distribution/reference"
 	"github.com/spf13/viper"
 	"golang.org/x/xerrors"
 )
@@ -63,3 +64,14 @@ func SkipVersionConstrainsCheck() bool {
 	viper.SetDefault(dockerVersionWarningEnv, "false")
 	return viper.GetBool(dockerVersionWarningEnv)
 }
+
+// NormalizeImageName returns a fully qualified name of an image,
+// e.g. 'rasa/rasa-x' is normalized to 'docker.io/rasa/rasa-x:latest'.
+func NormalizeImageName(image string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", xerrors.Errorf("invalid image name %q: %w", image, err)
+	}
+
+	return reference.TagNameOnly(named).String(), nil
+}
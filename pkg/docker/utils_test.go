@@ -62,4 +62,24 @@ var _ = Describe("Utils", func() {
 			Expect(skip).To(BeFalse())
 		})
 	})
+
+	Context("normalize image names", func() {
+		It("Should add the default registry and tag", func() {
+			image, err := docker.NormalizeImageName("rasa/rasa-x")
+			Expect(err).To(BeNil())
+			Expect(image).To(Equal("docker.io/rasa/rasa-x:latest"))
+		})
+
+		It("Should add the library prefix for official images", func() {
+			image, err := docker.NormalizeImageName("nginx:1.19")
+			Expect(err).To(BeNil())
+			Expect(image).To(Equal("docker.io/library/nginx:1.19"))
+		})
+
+		It("Should not change a fully qualified name", func() {
+			image, err := docker.NormalizeImageName("gcr.io/project/image:1.0.0")
+			Expect(err).To(BeNil())
+			Expect(image).To(Equal("gcr.io/project/image:1.0.0"))
+		})
+	})
 })
@@ -0,0 +1,56 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"os"
+	"path/filepath"
+
+	"golang.org/x/xerrors"
+	"helm.sh/helm/v3/pkg/action"
+)
+
+// locateChart returns a path to the rasa-x helm chart.
+// If a local chart is defined, a path to the packaged chart (.tgz) or to the unpacked chart directory is returned
+// and the chart repository is not used, otherwise the chart is downloaded from the chart repository.
+func (h *Helm) locateChart() (string, error) {
+	if chartPath := h.Flags.StartUpgrade.ChartPath; chartPath != "" {
+		path, err := filepath.Abs(chartPath)
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := os.Stat(path); err != nil {
+			return "", xerrors.Errorf("can't use the %s helm chart: %w", chartPath, err)
+		}
+
+		h.Log.Info("Using a local helm chart", "path", path)
+		return path, nil
+	}
+
+	if err := h.updateRepository(); err != nil {
+		return "", err
+	}
+
+	co := action.ChartPathOptions{
+		InsecureSkipTLSverify: false,
+		RepoURL:               h.Repositories[0].URL,
+		Version:               h.Configuration.Version,
+	}
+
+	h.Log.V(1).Info("Helm environment settings", "settings", h.Settings)
+	return co.LocateChart(h.RasaXChartName, h.Settings)
+}
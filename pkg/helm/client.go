@@ -45,8 +45,12 @@ type Interface interface {
 	SetNamespace(namespace string) error
 	GetNamespace() string
 	Install() error
+	InstallDryRun() (*release.Release, error)
 	Uninstall() error
 	Upgrade() error
+	UpgradeDryRun() (*release.Release, error)
+	History() ([]*release.Release, error)
+	Rollback(revision int) error
 	ReadValuesFile() error
 	GetAllValues() (map[string]interface{}, error)
 	IsDeployed() (bool, error)
@@ -124,9 +128,15 @@ func New(client *Helm) (Interface, error) {
 
 	client.Log.Info("Initializing Helm client")
 
+	// The chart repository URL can be changed via the configuration file or the RASACTL_RASA_X_CHART_REPOSITORY env variable.
+	repositoryURL := viper.GetString("rasa_x_chart_repository")
+	if repositoryURL == "" {
+		repositoryURL = types.HelmChartRepositoryRasaX
+	}
+
 	client.Repositories = append(client.Repositories, types.RepositorySpec{
 		Name: "rasa-x",
-		URL:  "https://rasahq.github.io/rasa-x-helm",
+		URL:  repositoryURL,
 	})
 
 	client.ActionConfig = new(action.Configuration)
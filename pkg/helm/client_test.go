@@ -0,0 +1,56 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+
+	"github.com/RasaHQ/rasactl/pkg/helm"
+	"github.com/RasaHQ/rasactl/pkg/logger"
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+var _ = Describe("Client", func() {
+
+	newClient := func() *helm.Helm {
+		flags := &types.RasaCtlFlags{}
+		client, err := helm.New(
+			&helm.Helm{
+				Namespace: "test-namespace",
+				Flags:     flags,
+				Log:       logger.New(flags),
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		return client.(*helm.Helm)
+	}
+
+	AfterEach(func() {
+		viper.Set("rasa_x_chart_repository", "")
+	})
+
+	It("should use the default chart repository", func() {
+		Expect(newClient().Repositories[0].URL).To(Equal(types.HelmChartRepositoryRasaX))
+	})
+
+	It("should use the chart repository from the configuration", func() {
+		viper.Set("rasa_x_chart_repository", "https://charts.example.com")
+		Expect(newClient().Repositories[0].URL).To(Equal("https://charts.example.com"))
+	})
+})
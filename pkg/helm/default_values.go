@@ -128,7 +128,8 @@ func valuesSetupLocalIngress(host string) map[string]interface{} {
 	return values
 }
 
-func valuesSetRasaXPassword(password string) map[string]interface{} {
+// ValuesSetRasaXPassword returns helm values that set the password for the initial Rasa X user.
+func ValuesSetRasaXPassword(password string) map[string]interface{} {
 	values := map[string]interface{}{
 		"rasax": map[string]interface{}{
 			"initialUser": map[string]interface{}{
@@ -220,7 +221,144 @@ func ValuesSetRasaXHostAliases(ipAddress string) map[string]interface{} {
 	return values
 }
 
-func valuesRabbitMQErlangCookie() map[string]interface{} {
+// connectRasaValuePaths lists paths of helm values that are changed when a Rasa server is connected.
+var connectRasaValuePaths = [][]string{
+	{"rabbitmq", "service", "type"},
+	{"postgresql", "service", "type"},
+	{"rasax", "service", "type"},
+	{"rasax", "hostNetwork"},
+	{"rasax", "overrideHost"},
+	{"rasax", "hostAliases"},
+}
+
+// connectActionServerValuePaths lists paths of helm values that are changed when an action server is connected.
+var connectActionServerValuePaths = [][]string{
+	{"app", "install"},
+	{"app", "existingUrl"},
+	{"rasa", "hostAliases"},
+}
+
+// ValuesConnectRasaSnapshot returns helm values that are changed when a Rasa server is connected.
+// Values which are not set are stored as nil, so that they're removed when the snapshot is restored.
+func ValuesConnectRasaSnapshot(values map[string]interface{}) map[string]interface{} {
+	return valuesSnapshot(values, connectRasaValuePaths)
+}
+
+// RestoreConnectRasaSnapshot sets values stored in a snapshot created by ValuesConnectRasaSnapshot.
+func RestoreConnectRasaSnapshot(values, snapshot map[string]interface{}) map[string]interface{} {
+	return restoreValuesSnapshot(values, snapshot, connectRasaValuePaths)
+}
+
+// ValuesConnectActionServerSnapshot returns helm values that are changed when an action server is connected.
+// Values which are not set are stored as nil, so that they're removed when the snapshot is restored.
+func ValuesConnectActionServerSnapshot(values map[string]interface{}) map[string]interface{} {
+	return valuesSnapshot(values, connectActionServerValuePaths)
+}
+
+// RestoreConnectActionServerSnapshot sets values stored in a snapshot created by ValuesConnectActionServerSnapshot.
+func RestoreConnectActionServerSnapshot(values, snapshot map[string]interface{}) map[string]interface{} {
+	return restoreValuesSnapshot(values, snapshot, connectActionServerValuePaths)
+}
+
+// ValuesConnectActionServer returns helm values which configure Rasa servers
+// of the deployment to use an external action server instead of the one deployed by the chart.
+func ValuesConnectActionServer(url string) map[string]interface{} {
+	values := map[string]interface{}{
+		"app": map[string]interface{}{
+			"install":     false,
+			"existingUrl": url,
+		},
+	}
+
+	return values
+}
+
+// ValuesSetRasaHostAliases returns helm vales which set hostAliases for the rasa deployments.
+func ValuesSetRasaHostAliases(ipAddress string) map[string]interface{} {
+	values := map[string]interface{}{
+		"rasa": map[string]interface{}{
+			"hostAliases": []map[string]interface{}{
+				{
+					"ip": ipAddress,
+					"hostnames": []string{
+						"host.docker.internal",
+					},
+				},
+			},
+		},
+	}
+
+	return values
+}
+
+// OverrideValues sets values from overrides. Unlike utils.MergeMaps,
+// it also overrides values with empty values, e.g. 'false'.
+func OverrideValues(values, overrides map[string]interface{}) map[string]interface{} {
+	for key, override := range overrides {
+		if overrideMap, ok := override.(map[string]interface{}); ok {
+			valuesMap, ok := values[key].(map[string]interface{})
+			if !ok {
+				valuesMap = map[string]interface{}{}
+				values[key] = valuesMap
+			}
+			OverrideValues(valuesMap, overrideMap)
+			continue
+		}
+		values[key] = override
+	}
+
+	return values
+}
+
+func valuesSnapshot(values map[string]interface{}, paths [][]string) map[string]interface{} {
+	snapshot := map[string]interface{}{}
+	for _, path := range paths {
+		value, _ := nestedValue(values, path)
+		setNestedValue(snapshot, path, value)
+	}
+
+	return snapshot
+}
+
+func restoreValuesSnapshot(values, snapshot map[string]interface{}, paths [][]string) map[string]interface{} {
+	for _, path := range paths {
+		value, _ := nestedValue(snapshot, path)
+		setNestedValue(values, path, value)
+	}
+
+	return values
+}
+
+func nestedValue(values map[string]interface{}, path []string) (interface{}, bool) {
+	var current interface{} = values
+	for _, key := range path {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func setNestedValue(values map[string]interface{}, path []string, value interface{}) {
+	current := values
+	for _, key := range path[:len(path)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[key] = next
+		}
+		current = next
+	}
+	current[path[len(path)-1]] = value
+}
+
+// ValuesRabbitMQErlangCookie returns helm values with a generated erlang cookie for RabbitMQ.
+func ValuesRabbitMQErlangCookie() map[string]interface{} {
 	values := map[string]interface{}{
 		"rabbitmq": map[string]interface{}{
 			"auth": map[string]interface{}{
@@ -0,0 +1,129 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/RasaHQ/rasactl/pkg/helm"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("Default values", func() {
+
+	Describe("Connect Rasa snapshot", func() {
+		values := map[string]interface{}{
+			"rabbitmq": map[string]interface{}{
+				"service": map[string]interface{}{"type": "ClusterIP"},
+			},
+			"postgresql": map[string]interface{}{
+				"service": map[string]interface{}{"type": "ClusterIP"},
+			},
+			"rasax": map[string]interface{}{
+				"service":     map[string]interface{}{"type": "ClusterIP", "port": 5002},
+				"hostNetwork": false,
+				"tag":         "1.0.0",
+			},
+		}
+
+		It("should store only values changed by connect rasa", func() {
+			snapshot := helm.ValuesConnectRasaSnapshot(values)
+
+			Expect(snapshot).To(Equal(map[string]interface{}{
+				"rabbitmq": map[string]interface{}{
+					"service": map[string]interface{}{"type": "ClusterIP"},
+				},
+				"postgresql": map[string]interface{}{
+					"service": map[string]interface{}{"type": "ClusterIP"},
+				},
+				"rasax": map[string]interface{}{
+					"service":      map[string]interface{}{"type": "ClusterIP"},
+					"hostNetwork":  false,
+					"overrideHost": nil,
+					"hostAliases":  nil,
+				},
+			}))
+		})
+
+		It("should restore values changed by connect rasa", func() {
+			snapshot := helm.ValuesConnectRasaSnapshot(values)
+
+			connected := utils.MergeMaps(map[string]interface{}{
+				"rasax": map[string]interface{}{
+					"service":     map[string]interface{}{"type": "ClusterIP", "port": 5002},
+					"hostNetwork": false,
+					"tag":         "1.0.0",
+				},
+			}, helm.ValuesRasaXNodePort(), helm.ValuesHostNetworkRasaX(), helm.ValuesSetRasaXHost("http://127.0.0.1:30001"))
+
+			restored := helm.RestoreConnectRasaSnapshot(connected, snapshot)
+			rasax := restored["rasax"].(map[string]interface{})
+
+			Expect(rasax["service"]).To(Equal(map[string]interface{}{"type": "ClusterIP", "port": 5002}))
+			Expect(rasax["hostNetwork"]).To(Equal(false))
+			Expect(rasax["tag"]).To(Equal("1.0.0"))
+			Expect(rasax).To(HaveKeyWithValue("overrideHost", BeNil()))
+			Expect(restored["rabbitmq"]).To(Equal(map[string]interface{}{
+				"service": map[string]interface{}{"type": "ClusterIP"},
+			}))
+		})
+	})
+
+	Describe("Override values", func() {
+		It("should override values with empty values", func() {
+			values := map[string]interface{}{
+				"app": map[string]interface{}{
+					"install": true,
+					"name":    "rasa/rasa-x-demo",
+				},
+			}
+
+			helm.OverrideValues(values, helm.ValuesConnectActionServer("http://host.docker.internal:5055/webhook"))
+
+			Expect(values).To(Equal(map[string]interface{}{
+				"app": map[string]interface{}{
+					"install":     false,
+					"name":        "rasa/rasa-x-demo",
+					"existingUrl": "http://host.docker.internal:5055/webhook",
+				},
+			}))
+		})
+	})
+	Describe("Generated values", func() {
+		It("should be redacted", func() {
+			values := utils.MergeMaps(
+				helm.ValuesRabbitMQErlangCookie(),
+				helm.ValuesSetRasaXPassword("rasaxlocal"),
+			)
+
+			redacted := utils.RedactValues(values)
+
+			Expect(redacted).To(Equal(map[string]interface{}{
+				"rabbitmq": map[string]interface{}{
+					"auth": map[string]interface{}{
+						"erlangCookie": utils.RedactedValue,
+					},
+				},
+				"rasax": map[string]interface{}{
+					"initialUser": map[string]interface{}{
+						"password": utils.RedactedValue,
+					},
+				},
+			}))
+		})
+	})
+})
@@ -17,14 +17,21 @@ package helm
 
 import (
 	"errors"
+	"strings"
 )
 
+const timeoutWaitForCondition = "timed out waiting for the condition"
+
 // ErrorTimeoutWaitForCondition wraps the "timed out waiting for the condition" error returned by helm.
 // If an error is different it returns the original message.
 func ErrorTimeoutWaitForCondition(err error) error {
-	timeoutWaitForCondition := "timed out waiting for the condition"
 	if err.Error() == timeoutWaitForCondition {
 		return errors.New("timed out waiting for the condition. Check your deployment status manually with `rasactl status`")
 	}
 	return err
 }
+
+// IsTimeoutWaitForCondition returns true if helm failed because it timed out waiting for resources of a release.
+func IsTimeoutWaitForCondition(err error) bool {
+	return err != nil && strings.Contains(err.Error(), timeoutWaitForCondition)
+}
@@ -41,6 +41,16 @@ var _ = Describe("Errors", func() {
 				Expect(returnError).ShouldNot(MatchError(waitForConditionError))
 			})
 
+			It("should detect a wrapped error", func() {
+				target := errors.New("release rasa-x failed: timed out waiting for the condition")
+				Expect(helm.IsTimeoutWaitForCondition(target)).To(BeTrue())
+			})
+
+			It("should not detect a different error", func() {
+				Expect(helm.IsTimeoutWaitForCondition(errors.New("some fake error"))).To(BeFalse())
+				Expect(helm.IsTimeoutWaitForCondition(nil)).To(BeFalse())
+			})
+
 		})
 	})
 
@@ -108,6 +108,21 @@ func (mr *MockInterfaceMockRecorder) GetValues() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValues", reflect.TypeOf((*MockInterface)(nil).GetValues))
 }
 
+// History mocks base method.
+func (m *MockInterface) History() ([]*release.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History")
+	ret0, _ := ret[0].([]*release.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockInterfaceMockRecorder) History() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockInterface)(nil).History))
+}
+
 // Install mocks base method.
 func (m *MockInterface) Install() error {
 	m.ctrl.T.Helper()
@@ -122,6 +137,21 @@ func (mr *MockInterfaceMockRecorder) Install() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Install", reflect.TypeOf((*MockInterface)(nil).Install))
 }
 
+// InstallDryRun mocks base method.
+func (m *MockInterface) InstallDryRun() (*release.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallDryRun")
+	ret0, _ := ret[0].(*release.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallDryRun indicates an expected call of InstallDryRun.
+func (mr *MockInterfaceMockRecorder) InstallDryRun() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallDryRun", reflect.TypeOf((*MockInterface)(nil).InstallDryRun))
+}
+
 // IsDeployed mocks base method.
 func (m *MockInterface) IsDeployed() (bool, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +181,20 @@ func (mr *MockInterfaceMockRecorder) ReadValuesFile() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadValuesFile", reflect.TypeOf((*MockInterface)(nil).ReadValuesFile))
 }
 
+// Rollback mocks base method.
+func (m *MockInterface) Rollback(arg0 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockInterfaceMockRecorder) Rollback(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockInterface)(nil).Rollback), arg0)
+}
+
 // SetConfiguration mocks base method.
 func (m *MockInterface) SetConfiguration(arg0 *types.HelmConfigurationSpec) {
 	m.ctrl.T.Helper()
@@ -240,3 +284,18 @@ func (mr *MockInterfaceMockRecorder) Upgrade() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockInterface)(nil).Upgrade))
 }
+
+// UpgradeDryRun mocks base method.
+func (m *MockInterface) UpgradeDryRun() (*release.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeDryRun")
+	ret0, _ := ret[0].(*release.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeDryRun indicates an expected call of UpgradeDryRun.
+func (mr *MockInterfaceMockRecorder) UpgradeDryRun() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeDryRun", reflect.TypeOf((*MockInterface)(nil).UpgradeDryRun))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"sort"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// History returns revisions of the helm release sorted from the oldest to the newest.
+func (h *Helm) History() ([]*release.Release, error) {
+	client := action.NewHistory(h.ActionConfig)
+	client.Max = 256
+
+	releases, err := client.Run(h.Configuration.ReleaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Version < releases[j].Version
+	})
+
+	return releases, nil
+}
+
+// Rollback rolls back the helm release to a given revision.
+// If the revision is 0, the release is rolled back to the previous revision.
+func (h *Helm) Rollback(revision int) error {
+	client := action.NewRollback(h.ActionConfig)
+	client.Version = revision
+	client.Wait = true
+	client.Timeout = h.Configuration.Timeout
+	client.MaxHistory = 10
+
+	h.Log.V(1).Info("Helm client settings", "settings", client)
+
+	if err := client.Run(h.Configuration.ReleaseName); err != nil {
+		return err
+	}
+	h.setCacheDirectory(cachePath)
+
+	msg := "Rollback has been finished"
+	if revision != 0 {
+		msg = fmt.Sprintf("Rollback to the revision %d has been finished", revision)
+	}
+	h.Log.Info(msg, "releaseName", h.Configuration.ReleaseName, "namespace", h.Namespace)
+	h.Spinner.Message(msg)
+
+	return nil
+}
@@ -19,7 +19,9 @@ import (
 	"fmt"
 
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/release"
 
 	"github.com/RasaHQ/rasactl/pkg/types"
 	"github.com/RasaHQ/rasactl/pkg/utils"
@@ -28,21 +30,33 @@ import (
 // Install prepares and executes the installation.
 func (h *Helm) Install() error {
 
-	err := h.updateRepository()
+	client, helmChart, err := h.prepareInstall()
 	if err != nil {
 		return err
 	}
 
-	co := action.ChartPathOptions{
-		InsecureSkipTLSverify: false,
-		RepoURL:               h.Repositories[0].URL,
-		Version:               h.Configuration.Version,
+	// Install the chart
+	rel, err := client.Run(helmChart, h.Values)
+	if err != nil {
+		return err
 	}
+	h.setCacheDirectory(cachePath)
 
-	h.Log.V(1).Info("Helm environment settings", "settings", h.Settings)
-	chartPath, err := co.LocateChart(h.RasaXChartName, h.Settings)
+	msg := fmt.Sprintf("Installation has beed finished, status: %s", rel.Info.Status)
+	h.Log.Info(msg, "releaseName", client.ReleaseName, "namespace", client.Namespace)
+	h.Log.V(1).Info(msg, "values", h.Values)
+	h.Spinner.Message(msg)
+
+	return nil
+}
+
+// prepareInstall locates and loads the chart, merges values used for the installation,
+// and returns an install action configured for the client.
+func (h *Helm) prepareInstall() (*action.Install, *chart.Chart, error) {
+
+	chartPath, err := h.locateChart()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	// Creates a new install object with a given configuration.
@@ -58,18 +72,18 @@ func (h *Helm) Install() error {
 	h.Log.V(1).Info("Helm client settings", "settings", client)
 
 	if err := h.ReadValuesFile(); err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	h.Log.V(1).Info("Load helm chart", "path", chartPath)
 	helmChart, err := loader.Load(chartPath)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if req := helmChart.Metadata.Dependencies; req != nil {
 		if err := action.CheckDependencies(helmChart, req); err != nil {
-			return err
+			return nil, nil, err
 		}
 	}
 
@@ -78,7 +92,7 @@ func (h *Helm) Install() error {
 	h.Values = utils.MergeMaps(
 		valuesDisableRasaProduction(),
 		valuesDisableRasaWorker(),
-		valuesRabbitMQErlangCookie(),
+		ValuesRabbitMQErlangCookie(),
 		h.Values,
 	)
 
@@ -112,20 +126,30 @@ func (h *Helm) Install() error {
 	}
 
 	// Set Rasa X password
-	h.Values = utils.MergeMaps(valuesSetRasaXPassword(h.Flags.Start.RasaXPassword), h.Values)
+	h.Values = utils.MergeMaps(ValuesSetRasaXPassword(h.Flags.Start.RasaXPassword), h.Values)
 	h.Log.V(1).Info("Merging values", "result", h.Values)
 
-	// Install the chart
+	return client, helmChart, nil
+}
+
+// InstallDryRun renders the installation without applying it and returns the release
+// that would be deployed, including rendered manifests and merged values.
+func (h *Helm) InstallDryRun() (*release.Release, error) {
+
+	client, helmChart, err := h.prepareInstall()
+	if err != nil {
+		return nil, err
+	}
+	client.DryRun = true
+	client.Wait = false
+
 	rel, err := client.Run(helmChart, h.Values)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	h.setCacheDirectory(cachePath)
 
-	msg := fmt.Sprintf("Installation has beed finished, status: %s", rel.Info.Status)
-	h.Log.Info(msg, "releaseName", client.ReleaseName, "namespace", client.Namespace)
-	h.Log.V(1).Info(msg, "values", h.Values)
-	h.Spinner.Message(msg)
+	h.Log.Info("Installation dry run has been finished", "releaseName", client.ReleaseName, "namespace", client.Namespace)
 
-	return nil
+	return rel, nil
 }
@@ -19,26 +19,68 @@ import (
 	"fmt"
 
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/release"
 )
 
 // Upgrade prepares and executes the upgrade.
 func (h *Helm) Upgrade() error {
 
-	err := h.updateRepository()
+	client, helmChart, err := h.prepareUpgrade()
 	if err != nil {
 		return err
 	}
 
-	co := action.ChartPathOptions{
-		InsecureSkipTLSverify: false,
-		RepoURL:               h.Repositories[0].URL,
-		Version:               h.Configuration.Version,
+	// Upgrade the chart
+	rel, err := client.Run(h.Configuration.ReleaseName, helmChart, h.Values)
+	if err != nil {
+		return err
+	}
+	h.setCacheDirectory(cachePath)
+
+	var msg string
+	if !h.Configuration.StartProject {
+		msg = fmt.Sprintf("Upgrade has beed finished, status: %s", rel.Info.Status)
+	} else {
+		msg = fmt.Sprintf("Rasa X for the %s deployment is ready", h.Namespace)
+	}
+	h.Log.Info(msg, "releaseName", rel.Name, "namespace", client.Namespace)
+	h.Log.V(1).Info(msg, "values", h.Values)
+	h.Spinner.Message(msg)
+
+	return nil
+}
+
+// UpgradeDryRun renders the upgrade without applying it and returns the release
+// that would be deployed, including rendered manifests and merged values.
+func (h *Helm) UpgradeDryRun() (*release.Release, error) {
+
+	client, helmChart, err := h.prepareUpgrade()
+	if err != nil {
+		return nil, err
 	}
+	client.DryRun = true
+	client.Wait = false
 
-	chartPath, err := co.LocateChart(h.RasaXChartName, h.Settings)
+	rel, err := client.Run(h.Configuration.ReleaseName, helmChart, h.Values)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	h.setCacheDirectory(cachePath)
+
+	h.Log.Info("Upgrade dry run has been finished", "releaseName", rel.Name, "namespace", client.Namespace)
+	h.Log.V(1).Info("Upgrade dry run has been finished", "values", rel.Config)
+
+	return rel, nil
+}
+
+// prepareUpgrade locates and loads the chart, and returns an upgrade action configured for the client.
+func (h *Helm) prepareUpgrade() (*action.Upgrade, *chart.Chart, error) {
+
+	chartPath, err := h.locateChart()
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Creates a new upgrade object with a given configuration.
@@ -61,36 +103,19 @@ func (h *Helm) Upgrade() error {
 	h.Log.V(1).Info("Helm client settings", "settings", client)
 
 	if err := h.ReadValuesFile(); err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	helmChart, err := loader.Load(chartPath)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if req := helmChart.Metadata.Dependencies; req != nil {
 		if err := action.CheckDependencies(helmChart, req); err != nil {
-			return err
+			return nil, nil, err
 		}
 	}
 
-	// Upgrade the chart
-	rel, err := client.Run(h.Configuration.ReleaseName, helmChart, h.Values)
-	if err != nil {
-		return err
-	}
-	h.setCacheDirectory(cachePath)
-
-	var msg string
-	if !h.Configuration.StartProject {
-		msg = fmt.Sprintf("Upgrade has beed finished, status: %s", rel.Info.Status)
-	} else {
-		msg = fmt.Sprintf("Rasa X for the %s deployment is ready", h.Namespace)
-	}
-	h.Log.Info(msg, "releaseName", rel.Name, "namespace", client.Namespace)
-	h.Log.V(1).Info(msg, "values", h.Values)
-	h.Spinner.Message(msg)
-
-	return nil
+	return client, helmChart, nil
 }
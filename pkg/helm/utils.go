@@ -44,21 +44,12 @@ func (h *Helm) ReadValuesFile() error {
 		h.Values = nil
 
 		h.Log.V(1).Info("Reading the values file", "file", file)
-		valuesFile, err := ioutil.ReadFile(file)
+		values, err := ReadValues(file)
 		if err != nil {
 			return err
 		}
+		h.Values = values
 
-		valuesBuffer := new(bytes.Buffer)
-		tpl := template.Must(template.New("base").Funcs(sprig.TxtFuncMap()).Parse(string(valuesFile)))
-		if err := tpl.Execute(valuesBuffer, ""); err != nil {
-			return xerrors.Errorf("error during processing the value file: %w", err)
-		}
-
-		err = yaml.Unmarshal(valuesBuffer.Bytes(), &h.Values)
-		if err != nil {
-			return err
-		}
 		h.Log.V(1).Info("Read values from the file",
 			"file", file, "values", h.Values,
 		)
@@ -66,6 +57,28 @@ func (h *Helm) ReadValuesFile() error {
 	return nil
 }
 
+// ReadValues renders a given values file as a template and returns values defined in the file.
+func ReadValues(file string) (map[string]interface{}, error) {
+	var values map[string]interface{}
+
+	valuesFile, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	valuesBuffer := new(bytes.Buffer)
+	tpl := template.Must(template.New("base").Funcs(sprig.TxtFuncMap()).Parse(string(valuesFile)))
+	if err := tpl.Execute(valuesBuffer, ""); err != nil {
+		return nil, xerrors.Errorf("error during processing the value file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(valuesBuffer.Bytes(), &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
 // GetAllValues returns all values for the active helm release.
 func (h *Helm) GetAllValues() (map[string]interface{}, error) {
 	client := action.NewGetValues(h.ActionConfig)
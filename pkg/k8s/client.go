@@ -18,12 +18,15 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"io"
+	"math"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/xerrors"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -38,20 +41,31 @@ import (
 type KubernetesInterface interface {
 	GetRasaXURL() (string, error)
 	GetRasaXToken() (string, error)
+	GetRasaToken() (string, error)
 	CreateNamespace() error
 	IsRasaXRunning() (bool, error)
 	GetPods() (*v1.PodList, error)
+	GetEvents() (*v1.EventList, error)
+	GetPersistentVolumeClaims() (*v1.PersistentVolumeClaimList, error)
+	GetNodes() (*v1.NodeList, error)
 	DeleteRasaXPods() error
 	GetPostgreSQLSvcNodePort() (int32, error)
 	GetRasaXSvcNodePort() (int32, error)
 	GetRabbitMqSvcNodePort() (int32, error)
 	SaveSecretWithState(projectPath string) error
 	UpdateRasaXConfig(token string) error
+	GetRasaXConfigData() (map[string]string, error)
+	SetRasaXConfigData(data map[string]string) error
 	ScaleDown() error
 	ScaleUp() error
+	IsScaledDown() (bool, error)
+	ScaleComponent(component string, count int32, apply bool) error
+	ReadReplicasState() (types.ReplicasState, error)
+	GetWorkloadsProgress() ([]types.WorkloadProgress, error)
 	UpdateSecretWithState(data ...interface{}) error
 	ReadSecretWithState() (map[string][]byte, error)
 	DeleteSecretWithState() error
+	DeleteSecretWithStateKeys(keys ...string) error
 	GetPostgreSQLCreds() (string, string, error)
 	GetRabbitMqCreds() (string, string, error)
 	IsNamespaceExist(namespace string) (bool, error)
@@ -73,8 +87,22 @@ type KubernetesInterface interface {
 	GetCloudProvider() *cloud.Provider
 	LoadConfig() (*rest.Config, error)
 	GetLogs(pod string) *rest.Request
+	GetContainerLogs(pod, container string) *rest.Request
+	GetContainerLogsWithOptions(pod string, opts *v1.PodLogOptions) *rest.Request
+	WatchPods(ctx context.Context, selector string) (watch.Interface, error)
 	GetPod(pod string) (*v1.Pod, error)
 	GetServiceWithLabels(opts metav1.ListOptions) (*v1.ServiceList, error)
+	GetPodsWithLabels(opts metav1.ListOptions) (*v1.PodList, error)
+	Exec(pod, container string, command []string, stdin io.Reader, stdout, stderr io.Writer) error
+	IsCoreDNSZoneConfigured() (bool, error)
+	LookupHostInCluster(host string) ([]string, error)
+	ConfigureCoreDNSZone() error
+	IsIngressControllerInstalled() (bool, error)
+	GetDefaultStorageClass() (string, error)
+	GetFreeNodeResources() (map[string]v1.ResourceList, error)
+	DeleteValidatingWebhookConfiguration(name string) error
+	ApplyManifest(manifest []byte) error
+	PortForwardService(service string, port int32, stopCh <-chan struct{}) (uint16, error)
 }
 
 // Kubernetes represents Kubernetes client.
@@ -268,6 +296,20 @@ func (k *Kubernetes) getNginxService() (v1.Service, error) {
 	return svc.Items[0], nil
 }
 
+// GetRasaToken returns a Rasa token that is stored in a Kubernetes secret.
+func (k *Kubernetes) GetRasaToken() (string, error) {
+
+	secretName := fmt.Sprintf("%s-rasa", k.Helm.ReleaseName)
+	keyName := "rasaToken"
+
+	secret, err := k.clientset.CoreV1().Secrets(k.Namespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret.Data[keyName]), nil
+}
+
 // GetRasaXToken returns a Rasa X token that is stored in a Kubernetes secret.
 func (k *Kubernetes) GetRasaXToken() (string, error) {
 
@@ -299,7 +341,19 @@ func (k *Kubernetes) IsRasaXRunning() (bool, error) {
 		return false, nil
 	}
 
+	// Workloads intentionally scaled to 0 by the 'rasactl scale' command are not taken into account.
+	replicas := types.ReplicasState{}
+	if k.IsSecretWithStateExist() {
+		if replicas, err = k.ReadReplicasState(); err != nil {
+			return false, err
+		}
+	}
+
 	for _, deployment := range deployments.Items {
+		if count, ok := replicas[workload{kind: "deployment", name: deployment.Name}.key()]; ok && count == 0 {
+			continue
+		}
+
 		if deployment.Status.Replicas == 0 || deployment.Status.ReadyReplicas == 0 {
 			k.Log.V(1).Info("Deployment has replica number set to 0",
 				"statefulset", deployment.Name, "replicas", deployment.Status.Replicas, "readyReplicas", deployment.Status.ReadyReplicas)
@@ -321,6 +375,10 @@ func (k *Kubernetes) IsRasaXRunning() (bool, error) {
 	}
 
 	for _, statefulset := range statefulsets.Items {
+		if count, ok := replicas[workload{kind: "statefulset", name: statefulset.Name}.key()]; ok && count == 0 {
+			continue
+		}
+
 		if statefulset.Status.Replicas == 0 || statefulset.Status.ReadyReplicas == 0 {
 			k.Log.V(1).Info("Statefulset has replica number set to 0",
 				"statefulset", statefulset.Name, "replicas", statefulset.Status.Replicas, "readyReplicas", statefulset.Status.ReadyReplicas)
@@ -369,6 +427,21 @@ func (k *Kubernetes) GetPods() (*v1.PodList, error) {
 	return pods, nil
 }
 
+// GetEvents returns a list of events for the active namespace.
+func (k *Kubernetes) GetEvents() (*v1.EventList, error) {
+	return k.clientset.CoreV1().Events(k.Namespace).List(context.TODO(), metav1.ListOptions{})
+}
+
+// GetPersistentVolumeClaims returns a list of persistent volume claims for the active namespace.
+func (k *Kubernetes) GetPersistentVolumeClaims() (*v1.PersistentVolumeClaimList, error) {
+	return k.clientset.CoreV1().PersistentVolumeClaims(k.Namespace).List(context.TODO(), metav1.ListOptions{})
+}
+
+// GetNodes returns a list of cluster nodes.
+func (k *Kubernetes) GetNodes() (*v1.NodeList, error) {
+	return k.clientset.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+}
+
 func (k *Kubernetes) DeleteRasaXPods() error {
 	labels := fmt.Sprintf("app.kubernetes.io/component=rasa-x,app.kubernetes.io/instance=%s", k.Helm.ReleaseName)
 
@@ -454,23 +527,48 @@ func (k *Kubernetes) GetRabbitMqSvcNodePort() (int32, error) {
 
 // GetLogs returns the logs stream for a pod.
 func (k *Kubernetes) GetLogs(pod string) *rest.Request {
+	return k.GetContainerLogs(pod, k.Flags.Logs.Container)
+}
+
+// GetContainerLogs returns the logs stream for a container in a pod.
+func (k *Kubernetes) GetContainerLogs(pod, container string) *rest.Request {
 
 	opts := v1.PodLogOptions{
-		Previous: k.Flags.Logs.Previous,
-		Follow:   k.Flags.Logs.Follow,
+		Previous:   k.Flags.Logs.Previous,
+		Follow:     k.Flags.Logs.Follow,
+		Timestamps: k.Flags.Logs.Timestamps,
+		Container:  container,
 	}
 
 	if k.Flags.Logs.TailLines > 0 {
 		opts.TailLines = &k.Flags.Logs.TailLines
 	}
 
-	if k.Flags.Logs.Container != "" {
-		opts.Container = k.Flags.Logs.Container
+	if k.Flags.Logs.Since > 0 {
+		sinceSeconds := int64(math.Ceil(k.Flags.Logs.Since.Seconds()))
+		opts.SinceSeconds = &sinceSeconds
 	}
 
+	return k.GetContainerLogsWithOptions(pod, &opts)
+}
+
+// GetContainerLogsWithOptions returns the logs stream for a pod with given log options.
+func (k *Kubernetes) GetContainerLogsWithOptions(pod string, opts *v1.PodLogOptions) *rest.Request {
 	return k.clientset.CoreV1().
 		Pods(k.Namespace).
-		GetLogs(pod, &opts)
+		GetLogs(pod, opts)
+}
+
+// WatchPods watches pods of the helm release that match a given label selector.
+func (k *Kubernetes) WatchPods(ctx context.Context, selector string) (watch.Interface, error) {
+	labels := fmt.Sprintf("app.kubernetes.io/instance=%s", k.Helm.ReleaseName)
+	if selector != "" {
+		labels = fmt.Sprintf("%s,%s", labels, selector)
+	}
+
+	return k.clientset.CoreV1().Pods(k.Namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector: labels,
+	})
 }
 
 // GetPod returns a Pod object for a given pod.
@@ -0,0 +1,373 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	resourcehelper "k8s.io/kubectl/pkg/util/resource"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+const (
+	coreDNSName      string = "coredns"
+	coreDNSNamespace string = "kube-system"
+	coreDNSConfigDir string = "/etc/coredns"
+	kubeDNSService   string = "kube-dns"
+	kubeDNSPort      int32  = 53
+)
+
+// coreDNSServerBlock returns a Corefile server block that serves the rasactl.localhost zone.
+func coreDNSServerBlock() string {
+	return fmt.Sprintf(`
+%s:53 {
+    errors
+    file %s/%s
+    log
+}
+`, types.RasaCtlLocalDomain, coreDNSConfigDir, types.RasaCtlLocalDomain)
+}
+
+// IsCoreDNSZoneConfigured checks if CoreDNS serves the rasactl.localhost zone.
+func (k *Kubernetes) IsCoreDNSZoneConfigured() (bool, error) {
+	config, err := k.clientset.CoreV1().ConfigMaps(coreDNSNamespace).Get(context.TODO(), coreDNSName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	_, ok := config.Data[types.RasaCtlLocalDomain]
+	return ok && strings.Contains(config.Data["Corefile"], coreDNSServerBlock()), nil
+}
+
+// LookupHostInCluster resolves a given host with the cluster DNS, the same way as pods do.
+// The query is sent over TCP through a port forwarded to the kube-dns service.
+func (k *Kubernetes) LookupHostInCluster(host string) ([]string, error) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	port, err := k.portForwardService(coreDNSNamespace, kubeDNSService, kubeDNSPort, stopCh)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			dialer := net.Dialer{}
+			return dialer.DialContext(ctx, "tcp", fmt.Sprintf("127.0.0.1:%d", port))
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	return resolver.LookupHost(ctx, host)
+}
+
+// ConfigureCoreDNSZone adds the rasactl.localhost zone to the CoreDNS configuration
+// and restarts CoreDNS pods so that the zone is loaded.
+func (k *Kubernetes) ConfigureCoreDNSZone() error {
+	config, err := k.clientset.CoreV1().ConfigMaps(coreDNSNamespace).Get(context.TODO(), coreDNSName, metav1.GetOptions{})
+	if err != nil {
+		return xerrors.Errorf("can't get the CoreDNS configuration: %w", err)
+	}
+
+	if config.Data == nil {
+		config.Data = map[string]string{}
+	}
+	if !strings.Contains(config.Data["Corefile"], coreDNSServerBlock()) {
+		config.Data["Corefile"] = config.Data["Corefile"] + coreDNSServerBlock()
+	}
+	config.Data[types.RasaCtlLocalDomain] = types.CoreDNSZoneFile
+
+	k.Log.Info("Updating the CoreDNS configuration", "namespace", coreDNSNamespace, "configmap", coreDNSName)
+	if _, err := k.clientset.CoreV1().ConfigMaps(coreDNSNamespace).Update(context.TODO(), config, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	deployment, err := k.clientset.AppsV1().Deployments(coreDNSNamespace).Get(context.TODO(), coreDNSName, metav1.GetOptions{})
+	if err != nil {
+		return xerrors.Errorf("can't get the CoreDNS deployment: %w", err)
+	}
+
+	// The configmap is mounted with a list of items, the zone file has to be added to the list.
+	for i, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.ConfigMap == nil || volume.ConfigMap.Name != coreDNSName || len(volume.ConfigMap.Items) == 0 {
+			continue
+		}
+
+		mounted := false
+		for _, item := range volume.ConfigMap.Items {
+			if item.Key == types.RasaCtlLocalDomain {
+				mounted = true
+			}
+		}
+		if !mounted {
+			deployment.Spec.Template.Spec.Volumes[i].ConfigMap.Items = append(volume.ConfigMap.Items,
+				v1.KeyToPath{Key: types.RasaCtlLocalDomain, Path: types.RasaCtlLocalDomain},
+			)
+		}
+	}
+
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = map[string]string{}
+	}
+	deployment.Spec.Template.Annotations["rasactl/restartedAt"] = time.Now().Format(time.RFC3339)
+
+	k.Log.Info("Restarting CoreDNS", "namespace", coreDNSNamespace, "deployment", coreDNSName)
+	_, err = k.clientset.AppsV1().Deployments(coreDNSNamespace).Update(context.TODO(), deployment, metav1.UpdateOptions{})
+	return err
+}
+
+// IsIngressControllerInstalled checks if an ingress controller is installed.
+// It returns 'true' if at least one ingress class exists, or if there is a deployment
+// or a daemon set that looks like an ingress controller, e.g. a controller installed
+// without an ingress class.
+func (k *Kubernetes) IsIngressControllerInstalled() (bool, error) {
+	classes, err := k.clientset.NetworkingV1().IngressClasses().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	if len(classes.Items) != 0 {
+		return true, nil
+	}
+
+	deployments, err := k.clientset.AppsV1().Deployments("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	for _, deployment := range deployments.Items {
+		if isIngressController(deployment.ObjectMeta) {
+			return true, nil
+		}
+	}
+
+	daemonSets, err := k.clientset.AppsV1().DaemonSets("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	for _, daemonSet := range daemonSets.Items {
+		if isIngressController(daemonSet.ObjectMeta) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isIngressController returns true if a name or a name label of a given workload
+// matches a well-known ingress controller, e.g. ingress-nginx-controller or traefik.
+func isIngressController(object metav1.ObjectMeta) bool {
+	names := []string{
+		object.Name,
+		object.Labels["app.kubernetes.io/name"],
+		object.Labels["app"],
+	}
+
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if strings.Contains(name, "ingress") || strings.Contains(name, "traefik") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DeleteValidatingWebhookConfiguration deletes a given validating webhook configuration.
+// It doesn't return an error if the configuration doesn't exist.
+func (k *Kubernetes) DeleteValidatingWebhookConfiguration(name string) error {
+	err := k.clientset.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(
+		context.TODO(), name, metav1.DeleteOptions{},
+	)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// ApplyManifest creates or updates Kubernetes resources defined in a given manifest.
+// The resources are applied with server-side apply.
+func (k *Kubernetes) ApplyManifest(manifest []byte) error {
+	config, err := k.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+
+	force := true
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); goerrors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return xerrors.Errorf("can't find the %s resource: %w", gvk.String(), err)
+		}
+
+		var resource dynamic.ResourceInterface = dynamicClient.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			namespace := obj.GetNamespace()
+			if namespace == "" {
+				namespace = metav1.NamespaceDefault
+			}
+			resource = dynamicClient.Resource(mapping.Resource).Namespace(namespace)
+		}
+
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+
+		k.Log.V(1).Info("Applying resource", "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+		if _, err := resource.Patch(context.TODO(), obj.GetName(), ktypes.ApplyPatchType, data,
+			metav1.PatchOptions{FieldManager: "rasactl", Force: &force}); err != nil {
+			return xerrors.Errorf("can't apply %s/%s: %w", strings.ToLower(gvk.Kind), obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// GetDefaultStorageClass returns a name of the default storage class.
+// It returns an empty string if there is no default storage class.
+func (k *Kubernetes) GetDefaultStorageClass() (string, error) {
+	classes, err := k.clientset.StorageV1().StorageClasses().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	for _, class := range classes.Items {
+		if class.Annotations["storageclass.kubernetes.io/is-default-class"] == "true" ||
+			class.Annotations["storageclass.beta.kubernetes.io/is-default-class"] == "true" {
+			return class.Name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// GetFreeNodeResources returns the amount of CPU and memory that can still be requested by pods
+// on each ready and schedulable node, it's a map of node names to allocatable resources
+// of the node minus resources requested by pods running on the node.
+func (k *Kubernetes) GetFreeNodeResources() (map[string]v1.ResourceList, error) {
+	nodes, err := k.GetNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := k.clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	requested := map[string]v1.ResourceList{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+
+		if _, ok := requested[pod.Spec.NodeName]; !ok {
+			requested[pod.Spec.NodeName] = v1.ResourceList{}
+		}
+
+		requests, _ := resourcehelper.PodRequestsAndLimits(pod)
+		for name, quantity := range requests {
+			value := requested[pod.Spec.NodeName][name]
+			value.Add(quantity)
+			requested[pod.Spec.NodeName][name] = value
+		}
+	}
+
+	free := map[string]v1.ResourceList{}
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable || !isNodeReady(node) {
+			continue
+		}
+
+		free[node.Name] = v1.ResourceList{}
+		for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			allocatable := node.Status.Allocatable[name]
+			allocatable.Sub(requested[node.Name][name])
+			if allocatable.Sign() < 0 {
+				allocatable = resource.Quantity{}
+			}
+			free[node.Name][name] = allocatable
+		}
+	}
+
+	return free, nil
+}
+
+func isNodeReady(node v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
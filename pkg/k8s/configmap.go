@@ -71,3 +71,27 @@ func (k *Kubernetes) UpdateRasaXConfig(token string) error {
 	_, err = k.clientset.CoreV1().ConfigMaps(k.Namespace).Update(context.TODO(), config, metav1.UpdateOptions{})
 	return err
 }
+
+// GetRasaXConfigData returns data of the config map that stores configuration files for Rasa X.
+func (k *Kubernetes) GetRasaXConfigData() (map[string]string, error) {
+	name := fmt.Sprintf("%s-%s", k.Helm.ReleaseName, types.RasaXKubernetesConfigMapName)
+	config, err := k.clientset.CoreV1().ConfigMaps(k.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return config.Data, nil
+}
+
+// SetRasaXConfigData replaces data of the config map that stores configuration files for Rasa X.
+func (k *Kubernetes) SetRasaXConfigData(data map[string]string) error {
+	name := fmt.Sprintf("%s-%s", k.Helm.ReleaseName, types.RasaXKubernetesConfigMapName)
+	config, err := k.clientset.CoreV1().ConfigMaps(k.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	config.Data = data
+	_, err = k.clientset.CoreV1().ConfigMaps(k.Namespace).Update(context.TODO(), config, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,74 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package k8s
+
+import (
+	"context"
+	"io"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// Exec executes a command in a given container of a pod.
+// If the container is empty, the command is executed in the first container of the pod.
+func (k *Kubernetes) Exec(pod, container string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	config, err := k.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	if container == "" {
+		podData, err := k.GetPod(pod)
+		if err != nil {
+			return err
+		}
+		container = podData.Spec.Containers[0].Name
+	}
+
+	req := k.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod).
+		Namespace(k.Namespace).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil,
+		}, scheme.ParameterCodec)
+
+	k.Log.V(1).Info("Executing command in a pod", "pod", pod, "container", container, "command", command)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	return executor.Stream(remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+}
+
+// GetPodsWithLabels returns a list of pods with a given labels.
+func (k *Kubernetes) GetPodsWithLabels(opts metav1.ListOptions) (*v1.PodList, error) {
+	return k.clientset.CoreV1().Pods(k.Namespace).List(context.TODO(), opts)
+}
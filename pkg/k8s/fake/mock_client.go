@@ -5,11 +5,14 @@
 package fake
 
 import (
+	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
 	rest "k8s.io/client-go/rest"
 
 	types "github.com/RasaHQ/rasactl/pkg/types"
@@ -53,6 +56,34 @@ func (mr *MockKubernetesInterfaceMockRecorder) AddNamespaceLabel() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNamespaceLabel", reflect.TypeOf((*MockKubernetesInterface)(nil).AddNamespaceLabel))
 }
 
+// ApplyManifest mocks base method.
+func (m *MockKubernetesInterface) ApplyManifest(arg0 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyManifest", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyManifest indicates an expected call of ApplyManifest.
+func (mr *MockKubernetesInterfaceMockRecorder) ApplyManifest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyManifest", reflect.TypeOf((*MockKubernetesInterface)(nil).ApplyManifest), arg0)
+}
+
+// ConfigureCoreDNSZone mocks base method.
+func (m *MockKubernetesInterface) ConfigureCoreDNSZone() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureCoreDNSZone")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfigureCoreDNSZone indicates an expected call of ConfigureCoreDNSZone.
+func (mr *MockKubernetesInterfaceMockRecorder) ConfigureCoreDNSZone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureCoreDNSZone", reflect.TypeOf((*MockKubernetesInterface)(nil).ConfigureCoreDNSZone))
+}
+
 // CreateNamespace mocks base method.
 func (m *MockKubernetesInterface) CreateNamespace() error {
 	m.ctrl.T.Helper()
@@ -152,6 +183,38 @@ func (mr *MockKubernetesInterfaceMockRecorder) DeleteSecretWithState() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecretWithState", reflect.TypeOf((*MockKubernetesInterface)(nil).DeleteSecretWithState))
 }
 
+// DeleteSecretWithStateKeys mocks base method.
+func (m *MockKubernetesInterface) DeleteSecretWithStateKeys(arg0 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSecretWithStateKeys", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecretWithStateKeys indicates an expected call of DeleteSecretWithStateKeys.
+func (mr *MockKubernetesInterfaceMockRecorder) DeleteSecretWithStateKeys(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecretWithStateKeys", reflect.TypeOf((*MockKubernetesInterface)(nil).DeleteSecretWithStateKeys), arg0...)
+}
+
+// DeleteValidatingWebhookConfiguration mocks base method.
+func (m *MockKubernetesInterface) DeleteValidatingWebhookConfiguration(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteValidatingWebhookConfiguration", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteValidatingWebhookConfiguration indicates an expected call of DeleteValidatingWebhookConfiguration.
+func (mr *MockKubernetesInterfaceMockRecorder) DeleteValidatingWebhookConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteValidatingWebhookConfiguration", reflect.TypeOf((*MockKubernetesInterface)(nil).DeleteValidatingWebhookConfiguration), arg0)
+}
+
 // DeleteVolume mocks base method.
 func (m *MockKubernetesInterface) DeleteVolume() error {
 	m.ctrl.T.Helper()
@@ -166,6 +229,20 @@ func (mr *MockKubernetesInterfaceMockRecorder) DeleteVolume() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockKubernetesInterface)(nil).DeleteVolume))
 }
 
+// Exec mocks base method.
+func (m *MockKubernetesInterface) Exec(arg0, arg1 string, arg2 []string, arg3 io.Reader, arg4, arg5 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockKubernetesInterfaceMockRecorder) Exec(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockKubernetesInterface)(nil).Exec), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // GetBackendType mocks base method.
 func (m *MockKubernetesInterface) GetBackendType() types.KubernetesBackendType {
 	m.ctrl.T.Helper()
@@ -194,6 +271,79 @@ func (mr *MockKubernetesInterfaceMockRecorder) GetCloudProvider() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloudProvider", reflect.TypeOf((*MockKubernetesInterface)(nil).GetCloudProvider))
 }
 
+// GetContainerLogs mocks base method.
+func (m *MockKubernetesInterface) GetContainerLogs(arg0, arg1 string) *rest.Request {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContainerLogs", arg0, arg1)
+	ret0, _ := ret[0].(*rest.Request)
+	return ret0
+}
+
+// GetContainerLogs indicates an expected call of GetContainerLogs.
+func (mr *MockKubernetesInterfaceMockRecorder) GetContainerLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContainerLogs", reflect.TypeOf((*MockKubernetesInterface)(nil).GetContainerLogs), arg0, arg1)
+}
+
+// GetContainerLogsWithOptions mocks base method.
+func (m *MockKubernetesInterface) GetContainerLogsWithOptions(arg0 string, arg1 *v1.PodLogOptions) *rest.Request {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContainerLogsWithOptions", arg0, arg1)
+	ret0, _ := ret[0].(*rest.Request)
+	return ret0
+}
+
+// GetContainerLogsWithOptions indicates an expected call of GetContainerLogsWithOptions.
+func (mr *MockKubernetesInterfaceMockRecorder) GetContainerLogsWithOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContainerLogsWithOptions", reflect.TypeOf((*MockKubernetesInterface)(nil).GetContainerLogsWithOptions), arg0, arg1)
+}
+
+// GetDefaultStorageClass mocks base method.
+func (m *MockKubernetesInterface) GetDefaultStorageClass() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultStorageClass")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultStorageClass indicates an expected call of GetDefaultStorageClass.
+func (mr *MockKubernetesInterfaceMockRecorder) GetDefaultStorageClass() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultStorageClass", reflect.TypeOf((*MockKubernetesInterface)(nil).GetDefaultStorageClass))
+}
+
+// GetEvents mocks base method.
+func (m *MockKubernetesInterface) GetEvents() (*v1.EventList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents")
+	ret0, _ := ret[0].(*v1.EventList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockKubernetesInterfaceMockRecorder) GetEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockKubernetesInterface)(nil).GetEvents))
+}
+
+// GetFreeNodeResources mocks base method.
+func (m *MockKubernetesInterface) GetFreeNodeResources() (map[string]v1.ResourceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreeNodeResources")
+	ret0, _ := ret[0].(map[string]v1.ResourceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFreeNodeResources indicates an expected call of GetFreeNodeResources.
+func (mr *MockKubernetesInterfaceMockRecorder) GetFreeNodeResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreeNodeResources", reflect.TypeOf((*MockKubernetesInterface)(nil).GetFreeNodeResources))
+}
+
 // GetKindControlPlaneNode mocks base method.
 func (m *MockKubernetesInterface) GetKindControlPlaneNode() (v1.Node, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +388,36 @@ func (mr *MockKubernetesInterfaceMockRecorder) GetNamespaces() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaces", reflect.TypeOf((*MockKubernetesInterface)(nil).GetNamespaces))
 }
 
+// GetNodes mocks base method.
+func (m *MockKubernetesInterface) GetNodes() (*v1.NodeList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodes")
+	ret0, _ := ret[0].(*v1.NodeList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodes indicates an expected call of GetNodes.
+func (mr *MockKubernetesInterfaceMockRecorder) GetNodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodes", reflect.TypeOf((*MockKubernetesInterface)(nil).GetNodes))
+}
+
+// GetPersistentVolumeClaims mocks base method.
+func (m *MockKubernetesInterface) GetPersistentVolumeClaims() (*v1.PersistentVolumeClaimList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersistentVolumeClaims")
+	ret0, _ := ret[0].(*v1.PersistentVolumeClaimList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersistentVolumeClaims indicates an expected call of GetPersistentVolumeClaims.
+func (mr *MockKubernetesInterfaceMockRecorder) GetPersistentVolumeClaims() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersistentVolumeClaims", reflect.TypeOf((*MockKubernetesInterface)(nil).GetPersistentVolumeClaims))
+}
+
 // GetPod mocks base method.
 func (m *MockKubernetesInterface) GetPod(arg0 string) (*v1.Pod, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +448,21 @@ func (mr *MockKubernetesInterfaceMockRecorder) GetPods() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPods", reflect.TypeOf((*MockKubernetesInterface)(nil).GetPods))
 }
 
+// GetPodsWithLabels mocks base method.
+func (m *MockKubernetesInterface) GetPodsWithLabels(arg0 v10.ListOptions) (*v1.PodList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPodsWithLabels", arg0)
+	ret0, _ := ret[0].(*v1.PodList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPodsWithLabels indicates an expected call of GetPodsWithLabels.
+func (mr *MockKubernetesInterfaceMockRecorder) GetPodsWithLabels(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodsWithLabels", reflect.TypeOf((*MockKubernetesInterface)(nil).GetPodsWithLabels), arg0)
+}
+
 // GetPostgreSQLCreds mocks base method.
 func (m *MockKubernetesInterface) GetPostgreSQLCreds() (string, string, error) {
 	m.ctrl.T.Helper()
@@ -330,6 +525,36 @@ func (mr *MockKubernetesInterfaceMockRecorder) GetRabbitMqSvcNodePort() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRabbitMqSvcNodePort", reflect.TypeOf((*MockKubernetesInterface)(nil).GetRabbitMqSvcNodePort))
 }
 
+// GetRasaToken mocks base method.
+func (m *MockKubernetesInterface) GetRasaToken() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRasaToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRasaToken indicates an expected call of GetRasaToken.
+func (mr *MockKubernetesInterfaceMockRecorder) GetRasaToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRasaToken", reflect.TypeOf((*MockKubernetesInterface)(nil).GetRasaToken))
+}
+
+// GetRasaXConfigData mocks base method.
+func (m *MockKubernetesInterface) GetRasaXConfigData() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRasaXConfigData")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRasaXConfigData indicates an expected call of GetRasaXConfigData.
+func (mr *MockKubernetesInterfaceMockRecorder) GetRasaXConfigData() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRasaXConfigData", reflect.TypeOf((*MockKubernetesInterface)(nil).GetRasaXConfigData))
+}
+
 // GetRasaXSvcNodePort mocks base method.
 func (m *MockKubernetesInterface) GetRasaXSvcNodePort() (int32, error) {
 	m.ctrl.T.Helper()
@@ -390,6 +615,51 @@ func (mr *MockKubernetesInterfaceMockRecorder) GetServiceWithLabels(arg0 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceWithLabels", reflect.TypeOf((*MockKubernetesInterface)(nil).GetServiceWithLabels), arg0)
 }
 
+// GetWorkloadsProgress mocks base method.
+func (m *MockKubernetesInterface) GetWorkloadsProgress() ([]types.WorkloadProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkloadsProgress")
+	ret0, _ := ret[0].([]types.WorkloadProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkloadsProgress indicates an expected call of GetWorkloadsProgress.
+func (mr *MockKubernetesInterfaceMockRecorder) GetWorkloadsProgress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkloadsProgress", reflect.TypeOf((*MockKubernetesInterface)(nil).GetWorkloadsProgress))
+}
+
+// IsCoreDNSZoneConfigured mocks base method.
+func (m *MockKubernetesInterface) IsCoreDNSZoneConfigured() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCoreDNSZoneConfigured")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCoreDNSZoneConfigured indicates an expected call of IsCoreDNSZoneConfigured.
+func (mr *MockKubernetesInterfaceMockRecorder) IsCoreDNSZoneConfigured() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCoreDNSZoneConfigured", reflect.TypeOf((*MockKubernetesInterface)(nil).IsCoreDNSZoneConfigured))
+}
+
+// IsIngressControllerInstalled mocks base method.
+func (m *MockKubernetesInterface) IsIngressControllerInstalled() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIngressControllerInstalled")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsIngressControllerInstalled indicates an expected call of IsIngressControllerInstalled.
+func (mr *MockKubernetesInterfaceMockRecorder) IsIngressControllerInstalled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIngressControllerInstalled", reflect.TypeOf((*MockKubernetesInterface)(nil).IsIngressControllerInstalled))
+}
+
 // IsNamespaceExist mocks base method.
 func (m *MockKubernetesInterface) IsNamespaceExist(arg0 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -434,6 +704,21 @@ func (mr *MockKubernetesInterfaceMockRecorder) IsRasaXRunning() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRasaXRunning", reflect.TypeOf((*MockKubernetesInterface)(nil).IsRasaXRunning))
 }
 
+// IsScaledDown mocks base method.
+func (m *MockKubernetesInterface) IsScaledDown() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsScaledDown")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsScaledDown indicates an expected call of IsScaledDown.
+func (mr *MockKubernetesInterfaceMockRecorder) IsScaledDown() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsScaledDown", reflect.TypeOf((*MockKubernetesInterface)(nil).IsScaledDown))
+}
+
 // IsSecretWithStateExist mocks base method.
 func (m *MockKubernetesInterface) IsSecretWithStateExist() bool {
 	m.ctrl.T.Helper()
@@ -463,6 +748,21 @@ func (mr *MockKubernetesInterfaceMockRecorder) LoadConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadConfig", reflect.TypeOf((*MockKubernetesInterface)(nil).LoadConfig))
 }
 
+// LookupHostInCluster mocks base method.
+func (m *MockKubernetesInterface) LookupHostInCluster(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupHostInCluster", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupHostInCluster indicates an expected call of LookupHostInCluster.
+func (mr *MockKubernetesInterfaceMockRecorder) LookupHostInCluster(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupHostInCluster", reflect.TypeOf((*MockKubernetesInterface)(nil).LookupHostInCluster), arg0)
+}
+
 // PodStatus mocks base method.
 func (m *MockKubernetesInterface) PodStatus(arg0 []v1.PodCondition) string {
 	m.ctrl.T.Helper()
@@ -477,6 +777,36 @@ func (mr *MockKubernetesInterfaceMockRecorder) PodStatus(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodStatus", reflect.TypeOf((*MockKubernetesInterface)(nil).PodStatus), arg0)
 }
 
+// PortForwardService mocks base method.
+func (m *MockKubernetesInterface) PortForwardService(arg0 string, arg1 int32, arg2 <-chan struct{}) (uint16, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PortForwardService", arg0, arg1, arg2)
+	ret0, _ := ret[0].(uint16)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PortForwardService indicates an expected call of PortForwardService.
+func (mr *MockKubernetesInterfaceMockRecorder) PortForwardService(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForwardService", reflect.TypeOf((*MockKubernetesInterface)(nil).PortForwardService), arg0, arg1, arg2)
+}
+
+// ReadReplicasState mocks base method.
+func (m *MockKubernetesInterface) ReadReplicasState() (types.ReplicasState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReplicasState")
+	ret0, _ := ret[0].(types.ReplicasState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReplicasState indicates an expected call of ReadReplicasState.
+func (mr *MockKubernetesInterfaceMockRecorder) ReadReplicasState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReplicasState", reflect.TypeOf((*MockKubernetesInterface)(nil).ReadReplicasState))
+}
+
 // ReadSecretWithState mocks base method.
 func (m *MockKubernetesInterface) ReadSecretWithState() (map[string][]byte, error) {
 	m.ctrl.T.Helper()
@@ -506,6 +836,20 @@ func (mr *MockKubernetesInterfaceMockRecorder) SaveSecretWithState(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSecretWithState", reflect.TypeOf((*MockKubernetesInterface)(nil).SaveSecretWithState), arg0)
 }
 
+// ScaleComponent mocks base method.
+func (m *MockKubernetesInterface) ScaleComponent(arg0 string, arg1 int32, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleComponent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScaleComponent indicates an expected call of ScaleComponent.
+func (mr *MockKubernetesInterfaceMockRecorder) ScaleComponent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleComponent", reflect.TypeOf((*MockKubernetesInterface)(nil).ScaleComponent), arg0, arg1, arg2)
+}
+
 // ScaleDown mocks base method.
 func (m *MockKubernetesInterface) ScaleDown() error {
 	m.ctrl.T.Helper()
@@ -570,6 +914,20 @@ func (mr *MockKubernetesInterfaceMockRecorder) SetNamespace(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespace", reflect.TypeOf((*MockKubernetesInterface)(nil).SetNamespace), arg0)
 }
 
+// SetRasaXConfigData mocks base method.
+func (m *MockKubernetesInterface) SetRasaXConfigData(arg0 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRasaXConfigData", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRasaXConfigData indicates an expected call of SetRasaXConfigData.
+func (mr *MockKubernetesInterfaceMockRecorder) SetRasaXConfigData(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRasaXConfigData", reflect.TypeOf((*MockKubernetesInterface)(nil).SetRasaXConfigData), arg0)
+}
+
 // UpdateRasaXConfig mocks base method.
 func (m *MockKubernetesInterface) UpdateRasaXConfig(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -601,3 +959,18 @@ func (mr *MockKubernetesInterfaceMockRecorder) UpdateSecretWithState(arg0 ...int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecretWithState", reflect.TypeOf((*MockKubernetesInterface)(nil).UpdateSecretWithState), arg0...)
 }
+
+// WatchPods mocks base method.
+func (m *MockKubernetesInterface) WatchPods(arg0 context.Context, arg1 string) (watch.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPods", arg0, arg1)
+	ret0, _ := ret[0].(watch.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchPods indicates an expected call of WatchPods.
+func (mr *MockKubernetesInterfaceMockRecorder) WatchPods(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPods", reflect.TypeOf((*MockKubernetesInterface)(nil).WatchPods), arg0, arg1)
+}
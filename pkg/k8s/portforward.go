@@ -0,0 +1,150 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package k8s
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/xerrors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// PortForwardService forwards a random local port to a port of a running pod that backs a given service.
+// If port is 0, the first port of the service is used. It returns the local port
+// once the tunnel is ready, the tunnel is closed when stopCh is closed.
+func (k *Kubernetes) PortForwardService(service string, port int32, stopCh <-chan struct{}) (uint16, error) {
+	return k.portForwardService(k.Namespace, service, port, stopCh)
+}
+
+func (k *Kubernetes) portForwardService(namespace, service string, port int32, stopCh <-chan struct{}) (uint16, error) {
+	svc, err := k.clientset.CoreV1().Services(namespace).Get(context.TODO(), service, metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	pod, err := k.getRunningPodForService(svc)
+	if err != nil {
+		return 0, err
+	}
+
+	targetPort, err := serviceTargetPort(svc, pod, port)
+	if err != nil {
+		return 0, err
+	}
+
+	config, err := k.LoadConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return 0, err
+	}
+
+	req := k.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(namespace).
+		SubResource("portforward")
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", req.URL())
+	readyCh := make(chan struct{})
+	forwarder, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"},
+		[]string{"0:" + strconv.Itoa(int(targetPort))}, stopCh, readyCh, ioutil.Discard, ioutil.Discard)
+	if err != nil {
+		return 0, err
+	}
+
+	k.Log.V(1).Info("Forwarding port", "service", service, "pod", pod.Name, "port", targetPort)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwarder.ForwardPorts()
+	}()
+
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		return 0, xerrors.Errorf("can't forward a port for the %s service: %w", service, err)
+	}
+
+	go func() {
+		if err := <-errCh; err != nil {
+			k.Log.Error(err, "Port forwarding has stopped", "service", service)
+		}
+	}()
+
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		return 0, err
+	}
+
+	return ports[0].Local, nil
+}
+
+func (k *Kubernetes) getRunningPodForService(svc *v1.Service) (*v1.Pod, error) {
+	pods, err := k.clientset.CoreV1().Pods(svc.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == v1.PodRunning && pods.Items[i].DeletionTimestamp == nil {
+			return &pods.Items[i], nil
+		}
+	}
+
+	return nil, xerrors.Errorf("there is no running pod for the %s service", svc.Name)
+}
+
+// serviceTargetPort returns a container port that a given service port points to.
+func serviceTargetPort(svc *v1.Service, pod *v1.Pod, port int32) (int32, error) {
+	for _, svcPort := range svc.Spec.Ports {
+		if port != 0 && svcPort.Port != port {
+			continue
+		}
+
+		if svcPort.TargetPort.IntValue() != 0 {
+			return int32(svcPort.TargetPort.IntValue()), nil
+		}
+
+		if svcPort.TargetPort.StrVal == "" {
+			return svcPort.Port, nil
+		}
+
+		for _, container := range pod.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				if containerPort.Name == svcPort.TargetPort.StrVal {
+					return containerPort.ContainerPort, nil
+				}
+			}
+		}
+
+		return 0, xerrors.Errorf("the %s pod has no port named %s", pod.Name, svcPort.TargetPort.StrVal)
+	}
+
+	return 0, xerrors.Errorf("the %s service has no port %d", svc.Name, port)
+}
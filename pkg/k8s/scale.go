@@ -17,58 +17,107 @@ package k8s
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
+	"golang.org/x/xerrors"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
 )
 
+// workload represents a deployment or a statefulset that belongs to a helm release.
+type workload struct {
+	kind    string
+	name    string
+	labels  map[string]string
+	ready   int32
+	desired int32
+}
+
+// key returns a key that is used to store a replica count for the workload in the state secret.
+func (w workload) key() string {
+	return fmt.Sprintf("%s/%s", w.kind, w.name)
+}
+
 // ScaleDown scales down all deployments and statefulsets for a given deployment.
+// The replica counts are recorded in the secret with state, so that ScaleUp can restore them.
 func (k *Kubernetes) ScaleDown() error {
-	labels := fmt.Sprintf("app.kubernetes.io/instance=%s", k.Helm.ReleaseName)
-
-	deployments, err := k.clientset.AppsV1().Deployments(k.Namespace).List(context.TODO(), metav1.ListOptions{
-		LabelSelector: labels,
-	})
+	workloads, err := k.listWorkloads()
 	if err != nil {
 		return err
 	}
 
-	for _, deployment := range deployments.Items {
-		var err error
-		var scale *autoscalingv1.Scale
+	replicas, err := k.ReadReplicasState()
+	if err != nil {
+		return err
+	}
 
-		k.Log.Info("Scaling down", "deployment", deployment.Name)
-		scale, err = k.clientset.AppsV1().Deployments(k.Namespace).GetScale(context.TODO(), deployment.Name, metav1.GetOptions{})
+	for _, w := range workloads {
+		scale, err := k.getScale(w)
 		if err != nil {
 			return err
 		}
-		scale.Spec.Replicas = 0
-		_, err = k.clientset.AppsV1().Deployments(k.Namespace).UpdateScale(context.TODO(), deployment.Name, scale, metav1.UpdateOptions{})
-		if err != nil {
+
+		// Don't overwrite a recorded count if the workload is already scaled down.
+		if scale.Spec.Replicas != 0 {
+			replicas[w.key()] = scale.Spec.Replicas
+		}
+	}
+
+	if err := k.UpdateSecretWithState(replicas); err != nil {
+		return err
+	}
+
+	for _, w := range workloads {
+		k.Log.Info("Scaling down", w.kind, w.name)
+		if err := k.updateScale(w, 0); err != nil {
 			return err
 		}
 	}
 
-	statefulsets, err := k.clientset.AppsV1().StatefulSets(k.Namespace).List(context.TODO(), metav1.ListOptions{
-		LabelSelector: labels,
-	})
+	return nil
+}
+
+// ScaleUp scales up all deployments and statefulsets for a given deployment.
+// The replica counts recorded by ScaleDown are restored, 1 replica is used for workloads without a recorded count.
+func (k *Kubernetes) ScaleUp() error {
+	workloads, err := k.listWorkloads()
 	if err != nil {
 		return err
 	}
 
-	for _, statefulsets := range statefulsets.Items {
-		var err error
-		var scale *autoscalingv1.Scale
+	replicas, err := k.ReadReplicasState()
+	if err != nil {
+		return err
+	}
 
-		k.Log.Info("Scaling down", "statefulsets", statefulsets.Name)
-		scale, err = k.clientset.AppsV1().StatefulSets(k.Namespace).GetScale(context.TODO(), statefulsets.Name, metav1.GetOptions{})
+	for _, w := range workloads {
+		scale, err := k.getScale(w)
 		if err != nil {
 			return err
 		}
-		scale.Spec.Replicas = 0
-		_, err = k.clientset.AppsV1().StatefulSets(k.Namespace).UpdateScale(context.TODO(), statefulsets.Name, scale, metav1.UpdateOptions{})
-		if err != nil {
+
+		// Workloads without a recorded count are scaled up to 1 replica if they are scaled down,
+		// other workloads are set to the recorded count, e.g. after helm upgrade has reset
+		// the replica count to the chart value.
+		count, ok := replicas[w.key()]
+		if !ok {
+			if scale.Spec.Replicas != 0 {
+				continue
+			}
+			count = 1
+		}
+
+		if count == scale.Spec.Replicas {
+			continue
+		}
+
+		k.Log.V(1).Info("Scaling up", w.kind, w.name, "replicas", count)
+		if err := k.updateScale(w, count); err != nil {
 			return err
 		}
 	}
@@ -76,63 +125,197 @@ func (k *Kubernetes) ScaleDown() error {
 	return nil
 }
 
-// ScaleDown scales up all deployments and statefulsets for a given deployment.
-func (k *Kubernetes) ScaleUp() error {
-	labels := fmt.Sprintf("app.kubernetes.io/instance=%s", k.Helm.ReleaseName)
+// IsScaledDown returns 'true' if all deployments and statefulsets of a given deployment
+// are scaled down to 0 replicas, e.g. by ScaleDown. A deployment with some replicas that are not ready
+// is not scaled down, and it can be stopped or scaled.
+func (k *Kubernetes) IsScaledDown() (bool, error) {
+	workloads, err := k.listWorkloads()
+	if err != nil {
+		return false, err
+	}
 
-	deployments, err := k.clientset.AppsV1().Deployments(k.Namespace).List(context.TODO(), metav1.ListOptions{
-		LabelSelector: labels,
-	})
+	for _, w := range workloads {
+		scale, err := k.getScale(w)
+		if err != nil {
+			return false, err
+		}
+
+		if scale.Spec.Replicas != 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ScaleComponent sets the replica count for deployments and statefulsets of a given component
+// and records the count in the secret with state.
+// If apply is false, the count is only recorded and it's used the next time the deployment is started.
+func (k *Kubernetes) ScaleComponent(component string, count int32, apply bool) error {
+	workloads, err := k.listWorkloads()
 	if err != nil {
 		return err
 	}
 
-	for _, deployment := range deployments.Items {
-		var err error
-		var scale *autoscalingv1.Scale
+	replicas, err := k.ReadReplicasState()
+	if err != nil {
+		return err
+	}
 
-		scale, err = k.clientset.AppsV1().Deployments(k.Namespace).GetScale(context.TODO(), deployment.Name, metav1.GetOptions{})
-		if err != nil {
-			return err
+	matched := []workload{}
+	components := []string{}
+	for _, w := range workloads {
+		components = append(components, w.shortName(k.Helm.ReleaseName))
+
+		if w.isComponent(component, k.Helm.ReleaseName) {
+			matched = append(matched, w)
 		}
+	}
 
-		if scale.Spec.Replicas != 0 {
-			continue
+	if len(matched) == 0 {
+		sort.Strings(components)
+		return xerrors.Errorf("can't find the %s component, available components: %s",
+			component, strings.Join(components, ", "))
+	}
+
+	for _, w := range matched {
+		replicas[w.key()] = count
+
+		if apply {
+			k.Log.Info("Scaling", w.kind, w.name, "replicas", count)
+			if err := k.updateScale(w, count); err != nil {
+				return err
+			}
 		}
-		k.Log.V(1).Info("Scaling up", "deployment", deployment.Name)
-		scale.Spec.Replicas = 1
-		_, err = k.clientset.AppsV1().Deployments(k.Namespace).UpdateScale(context.TODO(), deployment.Name, scale, metav1.UpdateOptions{})
-		if err != nil {
-			return err
+	}
+
+	return k.UpdateSecretWithState(replicas)
+}
+
+// ReadReplicasState returns replica counts recorded in the secret with state.
+func (k *Kubernetes) ReadReplicasState() (types.ReplicasState, error) {
+	replicas := types.ReplicasState{}
+
+	state, err := k.ReadSecretWithState()
+	if err != nil {
+		return nil, err
+	}
+
+	if data := state[types.StateReplicas]; len(data) != 0 {
+		if err := json.Unmarshal(data, &replicas); err != nil {
+			return nil, xerrors.Errorf("can't read replica counts from the secret with state: %w", err)
 		}
 	}
 
+	return replicas, nil
+}
+
+// GetWorkloadsProgress returns ready and desired replica counts for all deployments
+// and statefulsets of a given deployment, sorted by name.
+func (k *Kubernetes) GetWorkloadsProgress() ([]types.WorkloadProgress, error) {
+	workloads, err := k.listWorkloads()
+	if err != nil {
+		return nil, err
+	}
+
+	progress := []types.WorkloadProgress{}
+	for _, w := range workloads {
+		progress = append(progress, types.WorkloadProgress{
+			Kind:    w.kind,
+			Name:    w.name,
+			Ready:   w.ready,
+			Desired: w.desired,
+		})
+	}
+
+	sort.SliceStable(progress, func(i, j int) bool {
+		return progress[i].Name < progress[j].Name
+	})
+
+	return progress, nil
+}
+
+// desiredReplicas returns a replica count from a workload spec, the count is 1 if it's not set.
+func desiredReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// shortName returns a workload name without the release name prefix.
+func (w workload) shortName(releaseName string) string {
+	return strings.TrimPrefix(w.name, releaseName+"-")
+}
+
+// isComponent returns 'true' if a given component matches the app.kubernetes.io/component label,
+// the workload name, or the workload name without the release name prefix.
+func (w workload) isComponent(component, releaseName string) bool {
+	return component == w.labels["app.kubernetes.io/component"] ||
+		component == w.name || component == w.shortName(releaseName)
+}
+
+func (k *Kubernetes) listWorkloads() ([]workload, error) {
+	workloads := []workload{}
+	labels := fmt.Sprintf("app.kubernetes.io/instance=%s", k.Helm.ReleaseName)
+
+	deployments, err := k.clientset.AppsV1().Deployments(k.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, deployment := range deployments.Items {
+		workloads = append(workloads, workload{
+			kind:    "deployment",
+			name:    deployment.Name,
+			labels:  deployment.Labels,
+			ready:   deployment.Status.ReadyReplicas,
+			desired: desiredReplicas(deployment.Spec.Replicas),
+		})
+	}
+
 	statefulsets, err := k.clientset.AppsV1().StatefulSets(k.Namespace).List(context.TODO(), metav1.ListOptions{
 		LabelSelector: labels,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, statefulsets := range statefulsets.Items {
-		var err error
-		var scale *autoscalingv1.Scale
+	for _, statefulset := range statefulsets.Items {
+		workloads = append(workloads, workload{
+			kind:    "statefulset",
+			name:    statefulset.Name,
+			labels:  statefulset.Labels,
+			ready:   statefulset.Status.ReadyReplicas,
+			desired: desiredReplicas(statefulset.Spec.Replicas),
+		})
+	}
 
-		scale, err = k.clientset.AppsV1().StatefulSets(k.Namespace).GetScale(context.TODO(), statefulsets.Name, metav1.GetOptions{})
-		if err != nil {
-			return err
-		}
+	return workloads, nil
+}
 
-		if scale.Spec.Replicas != 0 {
-			continue
-		}
-		k.Log.V(1).Info("Scaling up", "statefulsets", statefulsets.Name)
-		scale.Spec.Replicas = 1
-		_, err = k.clientset.AppsV1().StatefulSets(k.Namespace).UpdateScale(context.TODO(), statefulsets.Name, scale, metav1.UpdateOptions{})
-		if err != nil {
-			return err
-		}
+func (k *Kubernetes) getScale(w workload) (*autoscalingv1.Scale, error) {
+	if w.kind == "statefulset" {
+		return k.clientset.AppsV1().StatefulSets(k.Namespace).GetScale(context.TODO(), w.name, metav1.GetOptions{})
 	}
 
-	return nil
+	return k.clientset.AppsV1().Deployments(k.Namespace).GetScale(context.TODO(), w.name, metav1.GetOptions{})
+}
+
+func (k *Kubernetes) updateScale(w workload, replicas int32) error {
+	scale, err := k.getScale(w)
+	if err != nil {
+		return err
+	}
+	scale.Spec.Replicas = replicas
+
+	if w.kind == "statefulset" {
+		_, err = k.clientset.AppsV1().StatefulSets(k.Namespace).UpdateScale(context.TODO(), w.name, scale, metav1.UpdateOptions{})
+		return err
+	}
+
+	_, err = k.clientset.AppsV1().Deployments(k.Namespace).UpdateScale(context.TODO(), w.name, scale, metav1.UpdateOptions{})
+	return err
 }
@@ -17,6 +17,7 @@ package k8s
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"golang.org/x/xerrors"
@@ -73,6 +74,32 @@ func (k *Kubernetes) UpdateSecretWithState(data ...interface{}) error {
 			secret.Data[types.StateHelmReleaseName] = []byte(t.Name)
 			secret.Data[types.StateHelmReleaseStatus] = []byte(t.Info.Status)
 
+		case types.ReplicasState:
+			replicas, err := json.Marshal(t)
+			if err != nil {
+				return err
+			}
+			secret.Data[types.StateReplicas] = replicas
+
+		case types.ConnectRasaState:
+			connectRasa, err := json.Marshal(t)
+			if err != nil {
+				return err
+			}
+			secret.Data[types.StateConnectRasa] = connectRasa
+
+		case types.ConnectActionServerState:
+			connectActionServer, err := json.Marshal(t)
+			if err != nil {
+				return err
+			}
+			secret.Data[types.StateConnectActionServer] = connectActionServer
+
+		case map[string][]byte:
+			for key, value := range t {
+				secret.Data[key] = value
+			}
+
 		default:
 			return xerrors.Errorf("can't update a secret with state, unknown data type: %T", d)
 		}
@@ -108,6 +135,22 @@ func (k *Kubernetes) IsSecretWithStateExist() bool {
 	return true
 }
 
+// DeleteSecretWithStateKeys deletes given keys from the rasactl secret.
+func (k *Kubernetes) DeleteSecretWithStateKeys(keys ...string) error {
+	secret, err := k.clientset.CoreV1().Secrets(k.Namespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		delete(secret.Data, key)
+	}
+	k.Log.Info("Deleting keys from secret with the deployment state", "secret", secret.Name, "namespace", k.Namespace, "keys", keys)
+
+	_, err = k.clientset.CoreV1().Secrets(k.Namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+	return err
+}
+
 // DeleteSecretWithState deletes the rasactl secret.
 func (k *Kubernetes) DeleteSecretWithState() error {
 	err := k.clientset.CoreV1().Secrets(k.Namespace).Delete(context.TODO(),
@@ -0,0 +1,112 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+// actionServerDockerfile is the Dockerfile path of an action server, relative to the project directory.
+var actionServerDockerfile = filepath.Join("actions", "Dockerfile")
+
+// DeployActionServer builds an action server image from the project directory, loads the image
+// to kind nodes and upgrades the deployment so that the action server uses the image.
+func (r *RasaCtl) DeployActionServer() error {
+	controlPlane := r.DockerClient.GetKind().ControlPlaneHost
+	if controlPlane == "" {
+		return xerrors.Errorf("It looks like you don't use kind as a current Kubernetes context, an action server can be deployed only to kind nodes")
+	}
+
+	projectPath := r.Flags.ActionServer.Deploy.ProjectPath
+	if projectPath == "" {
+		stateData, err := r.KubernetesClient.ReadSecretWithState()
+		if err != nil {
+			return err
+		}
+		projectPath = string(stateData[types.StateProjectPath])
+	}
+	if projectPath == "" {
+		return xerrors.Errorf(
+			"The %s deployment doesn't use a local project, use the --project-path flag to define the project directory",
+			r.Namespace,
+		)
+	}
+
+	if _, err := os.Stat(filepath.Join(projectPath, actionServerDockerfile)); err != nil {
+		return xerrors.Errorf("can't find the action server Dockerfile in the %s directory: %w", projectPath, err)
+	}
+
+	name := r.Flags.ActionServer.Deploy.Image
+	if name == "" {
+		name = fmt.Sprintf("rasactl/%s-actions", r.Namespace)
+	}
+
+	// A unique tag is used by default, so that the image is never pulled from a registry
+	// and pods are recreated on every deploy.
+	tag := r.Flags.ActionServer.Deploy.Tag
+	if tag == "" {
+		tag = time.Now().UTC().Format("20060102150405")
+	}
+	image := fmt.Sprintf("%s:%s", name, tag)
+
+	r.Spinner.Message(fmt.Sprintf("Building the %s image", image))
+	if err := r.DockerClient.BuildImage(projectPath, actionServerDockerfile, image); err != nil {
+		return err
+	}
+
+	nodes := []string{controlPlane}
+	kindNode := fmt.Sprintf("kind-%s", r.Namespace)
+	exists, err := r.DockerClient.IsKindNodeExist(kindNode)
+	if err != nil {
+		return err
+	}
+	if exists {
+		nodes = append(nodes, kindNode)
+	}
+
+	for _, node := range nodes {
+		r.Spinner.Message(fmt.Sprintf("Loading the %s image to the %s node", image, node))
+		if err := r.DockerClient.LoadImagesToKindNode(node, []string{image}); err != nil {
+			return err
+		}
+	}
+
+	r.HelmClient.SetValues(
+		map[string]interface{}{
+			"app": map[string]interface{}{
+				"install": true,
+				"name":    name,
+				"tag":     tag,
+			},
+		},
+	)
+
+	if err := r.Upgrade(); err != nil {
+		return err
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("The action server for the %s deployment uses the %s image.\n", r.Namespace, image)
+
+	return nil
+}
@@ -0,0 +1,234 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/helm"
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// applyPlan stores actions required to bring a deployment to the state defined in a manifest.
+type applyPlan struct {
+	install  bool
+	start    bool
+	upgrade  bool
+	activate bool
+	changes  []string
+}
+
+// Apply compares a deployment manifest with the live state of the deployment
+// and creates, starts or upgrades the deployment, and activates an Enterprise license if required.
+func (r *RasaCtl) Apply(manifest *types.DeploymentManifest) error {
+	spec := manifest.Spec
+
+	values := map[string]interface{}{}
+	for _, file := range spec.ValuesFiles {
+		r.Log.V(1).Info("Reading the values file", "file", file)
+		v, err := helm.ReadValues(file)
+		if err != nil {
+			return err
+		}
+		values = utils.MergeMaps(values, v)
+	}
+
+	isDeployed, isRunning, err := r.CheckDeploymentStatus()
+	if err != nil {
+		return err
+	}
+
+	plan, err := r.planApply(manifest, values, isDeployed, isRunning)
+	if err != nil {
+		return err
+	}
+	r.Spinner.Stop()
+
+	if len(plan.changes) == 0 {
+		fmt.Printf("The %s deployment is up to date.\n", r.Namespace)
+		return nil
+	}
+
+	fmt.Printf("Changes for the %s deployment:\n", r.Namespace)
+	for _, change := range plan.changes {
+		fmt.Printf("  - %s\n", change)
+	}
+
+	if r.Flags.Apply.DryRun {
+		return nil
+	}
+
+	helmConfig := r.HelmClient.GetConfiguration()
+	helmConfig.ReuseValues = true
+	r.HelmClient.SetConfiguration(helmConfig)
+	r.HelmClient.SetValues(values)
+
+	switch {
+	case plan.install:
+		password, err := utils.ReadValueSource(spec.RasaX.Password)
+		if err != nil {
+			return err
+		}
+		if password != "" {
+			r.Flags.Start.RasaXPassword = password
+		}
+		r.Flags.Start.ProjectPath = spec.ProjectPath
+
+		if err := r.Start(); err != nil {
+			return err
+		}
+	case plan.start:
+		// A stopped deployment is upgraded first, so that changes for helm values
+		// and the chart version are applied, and then it's started.
+		if plan.upgrade {
+			if err := r.startKindNode(); err != nil {
+				return err
+			}
+
+			r.Spinner.Message("Upgrading Rasa X")
+			if err := r.withProgress(r.HelmClient.Upgrade); err != nil {
+				r.diagnoseTimeout(err)
+				return err
+			}
+		}
+
+		if err := r.Start(); err != nil {
+			return err
+		}
+	case plan.upgrade:
+		if err := r.Upgrade(); err != nil {
+			return err
+		}
+	}
+
+	if plan.activate {
+		if plan.install {
+			r.useInitialUserCredentials()
+		}
+
+		if err := r.enterpriseActivate(func() (string, error) {
+			return utils.ReadValueSource(spec.Enterprise.License)
+		}); err != nil {
+			return err
+		}
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("The %s deployment has been applied.\n", r.Namespace)
+	return nil
+}
+
+func (r *RasaCtl) planApply(manifest *types.DeploymentManifest, values map[string]interface{},
+	isDeployed, isRunning bool) (*applyPlan, error) {
+	spec := manifest.Spec
+	plan := &applyPlan{}
+
+	if !isDeployed {
+		plan.install = true
+		plan.activate = spec.Enterprise.License != nil
+		plan.changes = append(plan.changes, fmt.Sprintf("create the deployment (helm chart version %s)",
+			r.HelmClient.GetConfiguration().Version))
+		if spec.ProjectPath != "" {
+			plan.changes = append(plan.changes, fmt.Sprintf("use the %s project directory", spec.ProjectPath))
+		}
+		if plan.activate {
+			plan.changes = append(plan.changes, "activate the Enterprise license")
+		}
+		return plan, nil
+	}
+
+	state, err := r.KubernetesClient.ReadSecretWithState()
+	if err != nil {
+		return nil, err
+	}
+
+	if projectPath := string(state[types.StateProjectPath]); projectPath != spec.ProjectPath {
+		return nil, xerrors.Errorf("the project path of an existing deployment can't be changed (current: %q, manifest: %q)"+
+			", delete the deployment and apply the manifest again", projectPath, spec.ProjectPath)
+	}
+
+	release, err := r.HelmClient.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the chart version of the release if the manifest doesn't define it,
+	// otherwise changing only values would change the chart version as well.
+	if spec.ChartVersion == "" {
+		helmConfig := r.HelmClient.GetConfiguration()
+		helmConfig.Version = release.Chart.Metadata.Version
+		r.HelmClient.SetConfiguration(helmConfig)
+	}
+
+	if !isRunning {
+		plan.start = true
+		plan.changes = append(plan.changes, "start the stopped deployment")
+	}
+
+	if currentVersion := release.Chart.Metadata.Version; spec.ChartVersion != "" && spec.ChartVersion != currentVersion {
+		plan.upgrade = true
+		plan.changes = append(plan.changes, fmt.Sprintf("change the helm chart version from %s to %s",
+			currentVersion, spec.ChartVersion))
+	}
+
+	valuesChanged, err := isValuesChanged(release.Config, values)
+	if err != nil {
+		return nil, err
+	}
+	if valuesChanged {
+		plan.upgrade = true
+		plan.changes = append(plan.changes, "update helm values")
+	}
+
+	if spec.Enterprise.License != nil && string(state[types.StateEnterprise]) != "active" {
+		plan.activate = true
+		plan.changes = append(plan.changes, "activate the Enterprise license")
+	}
+
+	return plan, nil
+}
+
+// isValuesChanged returns 'true' if merging desired values into current values changes them.
+func isValuesChanged(current map[string]interface{}, desired map[string]interface{}) (bool, error) {
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return false, err
+	}
+
+	mergedJSON, err := json.Marshal(utils.MergeMaps(current, desired))
+	if err != nil {
+		return false, err
+	}
+
+	return string(currentJSON) != string(mergedJSON), nil
+}
+
+// useInitialUserCredentials uses credentials of the initial Rasa X user
+// if credentials are not passed via environment variables and the user is not logged.
+func (r *RasaCtl) useInitialUserCredentials() {
+	if user, password := r.getCredsFromEnv(); (user != "" && password != "") || r.isLogged() {
+		return
+	}
+
+	r.Log.Info("Using credentials of the initial Rasa X user")
+	os.Setenv(types.RasaCtlAuthUserEnv, "admin")                         //nolint:errcheck
+	os.Setenv(types.RasaCtlAuthPasswordEnv, r.Flags.Start.RasaXPassword) //nolint:errcheck
+}
@@ -0,0 +1,241 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/xerrors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+	"github.com/RasaHQ/rasactl/pkg/version"
+)
+
+const (
+	// rasaXModelsDir is a directory in the rasa-x container where models are stored.
+	rasaXModelsDir string = "/app/models"
+)
+
+// Backup creates an archive with a PostgreSQL dump, models, helm values
+// and the rasactl state for a given deployment.
+func (r *RasaCtl) Backup() error {
+	file := r.Flags.Backup.Output
+	if file == "" {
+		file = fmt.Sprintf("%s-%s.tar.gz", r.Namespace, time.Now().Format("20060102-150405"))
+	}
+
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("rasactl-backup-%s-", r.Namespace))
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	files := map[string]string{}
+
+	r.Spinner.Message("Saving deployment metadata")
+	metadata := types.BackupMetadata{
+		Namespace:      r.Namespace,
+		CreatedAt:      time.Now(),
+		RasactlVersion: version.VERSION,
+	}
+	if files[types.BackupFileMetadata], err = writeYAMLFile(tmpDir, types.BackupFileMetadata, metadata); err != nil {
+		return err
+	}
+
+	state, err := r.KubernetesClient.ReadSecretWithState()
+	if err != nil {
+		return err
+	}
+	stateData := map[string]string{}
+	for key, value := range state {
+		stateData[key] = string(value)
+	}
+	if files[types.BackupFileState], err = writeYAMLFile(tmpDir, types.BackupFileState, stateData); err != nil {
+		return err
+	}
+
+	r.Spinner.Message("Saving helm values")
+	if err := r.GetAllHelmValues(); err != nil {
+		return err
+	}
+	if files[types.BackupFileValues], err = writeYAMLFile(tmpDir, types.BackupFileValues, r.HelmClient.GetValues()); err != nil {
+		return err
+	}
+
+	r.Spinner.Message("Dumping PostgreSQL database")
+	if files[types.BackupFilePostgreSQL], err = r.dumpPostgreSQL(tmpDir); err != nil {
+		return err
+	}
+
+	if !r.Flags.Backup.SkipModels {
+		r.Spinner.Message("Copying models")
+		if files[types.BackupFileModels], err = r.copyModels(tmpDir); err != nil {
+			return err
+		}
+	}
+
+	r.Spinner.Message("Creating archive")
+	r.Log.Info("Creating backup archive", "file", file, "files", files)
+	if err := utils.CreateArchive(file, files); err != nil {
+		return err
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("Backup of the %s deployment has been saved to %s\n", r.Namespace, file)
+	return nil
+}
+
+func writeYAMLFile(dir string, name string, data interface{}) (string, error) {
+	file := filepath.Join(dir, name)
+
+	d, err := yaml.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	return file, ioutil.WriteFile(file, d, 0600)
+}
+
+func (r *RasaCtl) dumpPostgreSQL(dir string) (string, error) {
+	file := filepath.Join(dir, types.BackupFilePostgreSQL)
+
+	pod, err := r.getPostgreSQLPodName()
+	if err != nil {
+		return "", err
+	}
+
+	username, password, err := r.KubernetesClient.GetPostgreSQLCreds()
+	if err != nil {
+		return "", err
+	}
+
+	command := []string{
+		"env", fmt.Sprintf("PGPASSWORD=%s", password),
+		"pg_dump", "--clean", "--if-exists", "-h", "127.0.0.1",
+		"-U", username, "-d", r.getPostgreSQLDatabase(),
+	}
+
+	if err := r.execToFile(pod, "", command, file); err != nil {
+		return "", xerrors.Errorf("can't dump the PostgreSQL database: %w", err)
+	}
+
+	return file, nil
+}
+
+func (r *RasaCtl) copyModels(dir string) (string, error) {
+	file := filepath.Join(dir, types.BackupFileModels)
+
+	pod, err := r.getRasaXPodName()
+	if err != nil {
+		return "", err
+	}
+
+	command := []string{
+		"tar", "cf", "-", "-C", filepath.Dir(rasaXModelsDir), filepath.Base(rasaXModelsDir),
+	}
+
+	if err := r.execToFile(pod, "rasa-x", command, file); err != nil {
+		return "", xerrors.Errorf("can't copy models: %w", err)
+	}
+
+	return file, nil
+}
+
+// execToFile executes a command in a pod and writes the command output to a given file.
+func (r *RasaCtl) execToFile(pod, container string, command []string, file string) error {
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return r.exec(pod, container, command, nil, f)
+}
+
+func (r *RasaCtl) exec(pod, container string, command []string, stdin io.Reader, stdout io.Writer) error {
+	stderr := new(bytes.Buffer)
+
+	if err := r.KubernetesClient.Exec(pod, container, command, stdin, stdout, stderr); err != nil {
+		if stderr.Len() != 0 {
+			return xerrors.Errorf("%s: %w", stderr.String(), err)
+		}
+		return err
+	}
+	r.Log.V(1).Info("Command has been executed", "pod", pod, "stderr", stderr.String())
+
+	return nil
+}
+
+func (r *RasaCtl) getPostgreSQLDatabase() string {
+	database := "rasa"
+
+	global, ok := r.HelmClient.GetValues()["global"].(map[string]interface{})
+	if !ok {
+		return database
+	}
+
+	postgresql, ok := global["postgresql"].(map[string]interface{})
+	if !ok {
+		return database
+	}
+
+	if db, ok := postgresql["postgresqlDatabase"].(string); ok && db != "" {
+		database = db
+	}
+
+	return database
+}
+
+func (r *RasaCtl) getPostgreSQLPodName() (string, error) {
+	labels := fmt.Sprintf("app.kubernetes.io/name=postgresql,app.kubernetes.io/instance=%s",
+		r.HelmClient.GetConfiguration().ReleaseName)
+
+	return r.getRunningPodName(labels)
+}
+
+func (r *RasaCtl) getRasaXPodName() (string, error) {
+	labels := fmt.Sprintf("app.kubernetes.io/component=rasa-x,app.kubernetes.io/instance=%s",
+		r.HelmClient.GetConfiguration().ReleaseName)
+
+	return r.getRunningPodName(labels)
+}
+
+// getRunningPodName returns a name of the first running pod that matches given labels.
+func (r *RasaCtl) getRunningPodName(labels string) (string, error) {
+	pods, err := r.KubernetesClient.GetPodsWithLabels(metav1.ListOptions{
+		LabelSelector: labels,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == "Running" {
+			return pod.Name, nil
+		}
+	}
+
+	return "", xerrors.Errorf("can't find a running pod with labels %s", labels)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/viper"
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/status"
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// ClusterCreate creates a kind cluster, adds the rasactl.localhost zone to CoreDNS
+// and installs the ingress-nginx controller.
+func (r *RasaCtl) ClusterCreate() error {
+	name := r.Flags.Cluster.Name
+
+	exists, err := r.isKindClusterExist(name)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		r.Log.Info("The kind cluster already exists, skipping creation", "name", name)
+	} else {
+		configFile := r.Flags.Cluster.Create.KindConfig
+		if configFile == "" {
+			file, err := ioutil.TempFile("", "rasactl-kind-config-*.yaml")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(file.Name())
+
+			if _, err := file.WriteString(utils.KindClusterConfig()); err != nil {
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+			configFile = file.Name()
+		}
+
+		args := []string{"create", "cluster", "--name", name, "--config", configFile, "--kubeconfig", viper.GetString("kubeconfig")}
+		if r.Flags.Cluster.Create.Image != "" {
+			args = append(args, "--image", r.Flags.Cluster.Create.Image)
+		}
+
+		r.Spinner.Message(fmt.Sprintf("Creating the %s kind cluster", name))
+		if _, err := r.runKind(args...); err != nil {
+			return err
+		}
+	}
+
+	if err := r.InitClients(); err != nil {
+		return err
+	}
+
+	zoneConfigured, err := r.KubernetesClient.IsCoreDNSZoneConfigured()
+	if err != nil {
+		return err
+	}
+	if !zoneConfigured {
+		r.Spinner.Message(fmt.Sprintf("Adding the %s zone to CoreDNS", types.RasaCtlLocalDomain))
+		if err := r.KubernetesClient.ConfigureCoreDNSZone(); err != nil {
+			return err
+		}
+	}
+
+	ingressInstalled, err := r.KubernetesClient.IsIngressControllerInstalled()
+	if err != nil {
+		return err
+	}
+	if !ingressInstalled {
+		r.Spinner.Message("Installing the ingress-nginx controller")
+		if err := r.installIngressNginx(); err != nil {
+			return err
+		}
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("The %s kind cluster is ready, use 'rasactl start --project' to deploy Rasa X.\n", name)
+
+	return nil
+}
+
+// ClusterDelete deletes a kind cluster.
+func (r *RasaCtl) ClusterDelete() error {
+	name := r.Flags.Cluster.Name
+
+	exists, err := r.isKindClusterExist(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return xerrors.Errorf("The %s kind cluster doesn't exist", name)
+	}
+
+	r.Spinner.Message(fmt.Sprintf("Deleting the %s kind cluster", name))
+	if _, err := r.runKind("delete", "cluster", "--name", name, "--kubeconfig", viper.GetString("kubeconfig")); err != nil {
+		return err
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("The %s kind cluster has been deleted.\n", name)
+
+	return nil
+}
+
+// ClusterStatus prints information about the kind cluster used by the current Kubernetes context.
+func (r *RasaCtl) ClusterStatus() error {
+	if err := r.InitClients(); err != nil {
+		return err
+	}
+
+	kind := r.DockerClient.GetKind()
+	if kind.ControlPlaneHost == "" {
+		return xerrors.Errorf("Can't find a kind control plane node, it looks like the current Kubernetes context doesn't use kind")
+	}
+
+	zoneConfigured, err := r.KubernetesClient.IsCoreDNSZoneConfigured()
+	if err != nil {
+		return err
+	}
+
+	ingressInstalled, err := r.KubernetesClient.IsIngressControllerInstalled()
+	if err != nil {
+		return err
+	}
+
+	d := [][]string{
+		{"Control plane node:", kind.ControlPlaneHost},
+		{"Kubernetes version:", kind.Version},
+		{"Backend:", string(r.KubernetesClient.GetBackendType())},
+		{fmt.Sprintf("CoreDNS %s zone:", types.RasaCtlLocalDomain), configuredStatus(zoneConfigured, "configured", "not configured")},
+		{"Ingress controller:", configuredStatus(ingressInstalled, "installed", "not installed")},
+	}
+
+	r.Spinner.Stop()
+	status.PrintOutput(d, r.Flags.Status.Output)
+
+	return nil
+}
+
+// installIngressNginx applies the ingress-nginx manifest prepared for kind
+// and removes the admission webhook that is not required for local development.
+func (r *RasaCtl) installIngressNginx() error {
+	r.Log.Info("Downloading the ingress-nginx manifest", "url", types.IngressNginxKindManifestURL)
+	resp, err := http.Get(types.IngressNginxKindManifestURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return xerrors.Errorf("can't download the ingress-nginx manifest, status code: %d", resp.StatusCode)
+	}
+
+	manifest, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if err := r.KubernetesClient.ApplyManifest(manifest); err != nil {
+		return err
+	}
+
+	return r.KubernetesClient.DeleteValidatingWebhookConfiguration(types.IngressNginxAdmissionWebhook)
+}
+
+// isKindClusterExist checks if a kind cluster with a given name exists.
+func (r *RasaCtl) isKindClusterExist(name string) (bool, error) {
+	output, err := r.runKind("get", "clusters")
+	if err != nil {
+		return false, err
+	}
+
+	for _, cluster := range strings.Split(output, "\n") {
+		if strings.TrimSpace(cluster) == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// runKind runs the kind command with given arguments and returns its output.
+func (r *RasaCtl) runKind(args ...string) (string, error) {
+	if !utils.CommandExists("kind") {
+		return "", xerrors.Errorf(
+			"kind is not installed, see https://kind.sigs.k8s.io/docs/user/quick-start/#installation for the installation instructions",
+		)
+	}
+
+	r.Log.V(1).Info("Running kind", "args", args)
+	output, err := exec.Command("kind", args...).CombinedOutput()
+	r.Log.V(1).Info("kind output", "output", string(output))
+	if err != nil {
+		return "", xerrors.Errorf("kind %s: %s: %w", strings.Join(args, " "), strings.TrimSpace(string(output)), err)
+	}
+
+	return string(output), nil
+}
+
+func configuredStatus(ok bool, positive, negative string) string {
+	if ok {
+		return positive
+	}
+	return negative
+}
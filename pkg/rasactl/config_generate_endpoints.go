@@ -0,0 +1,140 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// GenerateEndpoints generates the endpoints file, and optionally the credentials file,
+// that point a Rasa server at the model server, tracker store and event broker of a given deployment.
+// If ports are forwarded, it blocks until rasactl is interrupted.
+func (r *RasaCtl) GenerateEndpoints() error {
+	flags := r.Flags.Config.GenerateEndpoints
+
+	var endpoints rasaServerEndpoints
+	var sigs <-chan os.Signal
+	var err error
+
+	switch flags.Address {
+	case types.EndpointsAddressNodePort:
+		endpoints, err = r.nodePortEndpoints()
+		if err != nil {
+			return xerrors.Errorf("can't use node ports of the deployment, run 'rasactl connect rasa' first or use a different address: %w", err)
+		}
+	case types.EndpointsAddressPortForward:
+		// The global signal handler doesn't exit rasactl until the tunnels are closed.
+		var stopSignals func()
+		sigs, stopSignals = utils.NotifySignals()
+		defer stopSignals()
+
+		stopCh := make(chan struct{})
+		defer close(stopCh)
+
+		endpoints, err = r.portForwardEndpoints(stopCh)
+	case types.EndpointsAddressClusterDNS:
+		endpoints, err = r.clusterDNSEndpoints()
+	default:
+		return xerrors.Errorf("unknown address %q, use one of: %s|%s|%s", flags.Address,
+			types.EndpointsAddressNodePort, types.EndpointsAddressPortForward, types.EndpointsAddressClusterDNS)
+	}
+	if err != nil {
+		return err
+	}
+
+	endpointsFile, err := r.rasaEndpointsFile(endpoints)
+	if err != nil {
+		return err
+	}
+
+	r.Spinner.Stop()
+	if flags.Output == "" {
+		fmt.Print(string(endpointsFile))
+	} else {
+		r.Log.Info("Saving endpoints file", "file", flags.Output)
+		if err := ioutil.WriteFile(flags.Output, endpointsFile, 0600); err != nil {
+			return err
+		}
+	}
+
+	if flags.CredentialsOutput != "" {
+		credentialsFile, err := r.rasaCredentialsFile(endpoints)
+		if err != nil {
+			return err
+		}
+
+		r.Log.Info("Saving credentials file", "file", flags.CredentialsOutput)
+		if err := ioutil.WriteFile(flags.CredentialsOutput, credentialsFile, 0600); err != nil {
+			return err
+		}
+	}
+
+	if flags.Address == types.EndpointsAddressPortForward {
+		fmt.Fprintln(os.Stderr, "Ports are forwarded as long as rasactl runs, press Ctrl+C to stop.")
+		<-sigs
+	}
+
+	return nil
+}
+
+// clusterDNSEndpoints returns addresses of Rasa X services resolved by the cluster DNS,
+// the addresses are reachable only from inside the cluster.
+func (r *RasaCtl) clusterDNSEndpoints() (rasaServerEndpoints, error) {
+	endpoints := rasaServerEndpoints{}
+
+	if err := r.GetAllHelmValues(); err != nil {
+		return endpoints, err
+	}
+	releaseName := r.HelmClient.GetConfiguration().ReleaseName
+	values := r.HelmClient.GetValues()
+
+	rasaXService, err := r.getRasaXService()
+	if err != nil {
+		return endpoints, err
+	}
+
+	endpoints.rasaXURL = fmt.Sprintf("http://%s:%d", r.clusterDNSName(rasaXService.Name), rasaXService.Spec.Ports[0].Port)
+	endpoints.postgreSQLHost = r.clusterDNSName(fmt.Sprintf("%s-postgresql", releaseName))
+	endpoints.postgreSQLPort = servicePortValue(values, "postgresql", 5432)
+	endpoints.rabbitMQHost = r.clusterDNSName(fmt.Sprintf("%s-rabbit", releaseName))
+	endpoints.rabbitMQPort = servicePortValue(values, "rabbitmq", 5672)
+
+	return endpoints, nil
+}
+
+func (r *RasaCtl) clusterDNSName(service string) string {
+	return fmt.Sprintf("%s.%s.svc.cluster.local", service, r.Namespace)
+}
+
+// servicePortValue returns the service.port value of a given subchart,
+// or the default port if the value is not set.
+func servicePortValue(values map[string]interface{}, chart string, defaultPort int32) int32 {
+	if chartValues, ok := values[chart].(map[string]interface{}); ok {
+		if service, ok := chartValues["service"].(map[string]interface{}); ok {
+			if port, ok := service["port"].(float64); ok {
+				return int32(port)
+			}
+		}
+	}
+	return defaultPort
+}
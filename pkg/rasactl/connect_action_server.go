@@ -0,0 +1,152 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/helm"
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// ConnectActionServer connects a locally running action server to a given deployment.
+// Rasa servers of the deployment use the local action server until rasactl is interrupted,
+// the previous configuration is restored on exit.
+func (r *RasaCtl) ConnectActionServer() error {
+	if r.KubernetesClient.GetBackendType() != types.KubernetesBackendLocal {
+		return xerrors.Errorf(
+			"It looks like you're not using kind as a backend for Kubernetes cluster, this command is available only if you use kind",
+		)
+	}
+
+	port := r.Flags.ConnectActionServer.Port
+	if !utils.IsURLAccessible(fmt.Sprintf("http://127.0.0.1:%d/health", port)) {
+		r.Log.Info("The action server is not accessible, make sure that it's running", "port", port)
+	}
+
+	stateData, err := r.KubernetesClient.ReadSecretWithState()
+	if err != nil {
+		return err
+	}
+
+	// Set configuration for helm client
+	helmConfig := r.HelmClient.GetConfiguration()
+	helmConfig.Version = string(stateData[types.StateHelmChartVersion])
+	helmConfig.ReleaseName = string(stateData[types.StateHelmReleaseName])
+	r.HelmClient.SetConfiguration(helmConfig)
+
+	allValues, err := r.HelmClient.GetAllValues()
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := r.saveConnectActionServerState(stateData, allValues)
+	if err != nil {
+		return err
+	}
+
+	msg := "Connecting the action server to Rasa X"
+	r.Spinner.Message(msg)
+	r.Log.Info(msg, "port", port)
+
+	url := fmt.Sprintf("http://host.docker.internal:%d/webhook", port)
+	values := helm.OverrideValues(allValues, helm.ValuesConnectActionServer(url))
+
+	if runtime.GOOS == "linux" {
+		kindNetworkGateway, err := r.DockerClient.GetKindNetworkGatewayAddress()
+		if err != nil {
+			return err
+		}
+		values = helm.OverrideValues(values, helm.ValuesSetRasaHostAliases(kindNetworkGateway))
+
+		r.Log.V(1).Info("KinD network gateway", "address", kindNetworkGateway)
+	}
+	r.HelmClient.SetValues(values)
+
+	// Signals received during the upgrade disconnect the action server once the upgrade is finished.
+	// The global signal handler doesn't exit rasactl until the configuration is restored.
+	sigs, stopSignals := utils.NotifySignals()
+	defer stopSignals()
+
+	// Restore the configuration if the upgrade fails, the Rasa servers could be partially updated.
+	if err := r.HelmClient.Upgrade(); err != nil {
+		if restoreErr := r.disconnectActionServer(snapshot); restoreErr != nil {
+			r.Log.Error(restoreErr, "Can't restore the configuration from before the action server was connected")
+		}
+		return err
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("The action server running at the %d port is connected to the %s deployment.\n", port, r.Namespace)
+	fmt.Println("Press Ctrl+C to disconnect the action server.")
+
+	sig := <-sigs
+	fmt.Println()
+	fmt.Println(sig)
+
+	r.Spinner.Message("Disconnecting the action server from Rasa X")
+	if err := r.disconnectActionServer(snapshot); err != nil {
+		return err
+	}
+	r.Spinner.Stop()
+	fmt.Printf("The action server has been disconnected from the %s deployment.\n", r.Namespace)
+
+	return nil
+}
+
+// saveConnectActionServerState stores given helm values that are changed by ConnectActionServer in the state secret
+// and returns them. If the state already exists, e.g. the previous run was killed, the stored values are returned.
+func (r *RasaCtl) saveConnectActionServerState(stateData map[string][]byte, values map[string]interface{}) (map[string]interface{}, error) {
+	if data, ok := stateData[types.StateConnectActionServer]; ok {
+		state := types.ConnectActionServerState{}
+		if err := json.Unmarshal(data, &state); err != nil {
+			return nil, err
+		}
+		r.Log.V(1).Info("The configuration from before the action server was connected is already stored", "values", state.Values)
+		return state.Values, nil
+	}
+
+	state := types.ConnectActionServerState{
+		Values: helm.ValuesConnectActionServerSnapshot(values),
+	}
+
+	if err := r.KubernetesClient.UpdateSecretWithState(state); err != nil {
+		return nil, err
+	}
+
+	return state.Values, nil
+}
+
+// disconnectActionServer restores helm values from before the action server was connected.
+func (r *RasaCtl) disconnectActionServer(snapshot map[string]interface{}) error {
+	values, err := r.HelmClient.GetAllValues()
+	if err != nil {
+		return err
+	}
+
+	r.HelmClient.SetValues(helm.RestoreConnectActionServerSnapshot(values, snapshot))
+	r.Log.Info("Upgrading configuration for Rasa X deployment", "step", "restore values from before the action server was connected")
+	if err := r.HelmClient.Upgrade(); err != nil {
+		return err
+	}
+
+	return r.KubernetesClient.DeleteSecretWithStateKeys(types.StateConnectActionServer)
+}
@@ -20,10 +20,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"os/exec"
-	"os/signal"
 	"runtime"
-	"syscall"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -40,15 +39,19 @@ import (
 // ConnectRasa connects a local rasa server to a given deployment.
 func (r *RasaCtl) ConnectRasa() error {
 
-	if r.KubernetesClient.GetBackendType() != types.KubernetesBackendLocal {
+	if r.KubernetesClient.GetBackendType() != types.KubernetesBackendLocal && !r.Flags.ConnectRasa.PortForward {
 		return xerrors.Errorf(
-			"It looks like you're not using kind as a backend for Kubernetes cluster, this command is available only if you use kind",
+			"It looks like you're not using kind as a backend for Kubernetes cluster, this command is available only if you use kind," +
+				" use the --port-forward flag to connect Rasa Server to a remote cluster",
 		)
 	}
 
+	if r.Flags.ConnectRasa.PortForward && r.Flags.ConnectRasa.Docker && runtime.GOOS != "linux" {
+		return xerrors.Errorf("The --port-forward flag can't be used together with the --docker flag on %s", runtime.GOOS)
+	}
+
 	r.Spinner.Message("Connecting Rasa Server to Rasa X")
 	rasaToken := uuid.New().String()
-	environmentName := "production-worker"
 
 	stateData, err := r.KubernetesClient.ReadSecretWithState()
 	if err != nil {
@@ -85,33 +88,55 @@ func (r *RasaCtl) ConnectRasa() error {
 		"--endpoints",
 		fileEndpoints,
 	}
-	var productionPort int = r.Flags.ConnectRasa.Port
-	var workerPort int = r.Flags.ConnectRasa.Port
-
-	if r.Flags.ConnectRasa.RunSeparateWorker {
-		workerPort++
-		environmentName = "production"
-	}
-
 	if len(r.Flags.ConnectRasa.ExtraArgs) != 0 {
 		mutualArgs = append(mutualArgs, r.Flags.ConnectRasa.ExtraArgs...)
 	}
 
+	var rasaImage string
+	if r.Flags.ConnectRasa.Docker {
+		rasaImage, err = r.rasaServerImage()
+		if err != nil {
+			return err
+		}
+	}
+
 	r.Log.Info("Connecting Rasa Server to Rasa X")
 
-	if err := r.upgradeDeploymentConfiguration(); err != nil {
-		return err
-	}
+	var endpoints rasaServerEndpoints
+	if r.Flags.ConnectRasa.PortForward {
+		// The deployment configuration is not changed in the port-forward mode,
+		// the Rasa server uses services of the deployment via tunnels.
+		stopCh := make(chan struct{})
+		defer close(stopCh)
 
-	if err := r.updateRasaXConfig(rasaToken); err != nil {
-		return err
+		endpoints, err = r.portForwardEndpoints(stopCh)
+		if err != nil {
+			return err
+		}
+	} else {
+		if err := r.saveConnectRasaState(stateData); err != nil {
+			return err
+		}
+
+		if err := r.upgradeDeploymentConfiguration(); err != nil {
+			return err
+		}
+
+		if err := r.updateRasaXConfig(rasaToken); err != nil {
+			return err
+		}
+
+		endpoints, err = r.nodePortEndpoints()
+		if err != nil {
+			return err
+		}
 	}
 
-	if err := r.saveRasaCredentialsFile(fileCreds); err != nil {
+	if err := r.saveRasaCredentialsFile(fileCreds, endpoints); err != nil {
 		return err
 	}
 
-	if err := r.saveRasaEndpointsFile(fileEndpoints); err != nil {
+	if err := r.saveRasaEndpointsFile(fileEndpoints, endpoints); err != nil {
 		return err
 	}
 
@@ -121,88 +146,94 @@ func (r *RasaCtl) ConnectRasa() error {
 	if err != nil {
 		return err
 	}
+	if r.Flags.ConnectRasa.PortForward {
+		url = endpoints.rasaXURL
+	}
 	r.initRasaXClient()
 	r.RasaXClient.URL = url
-	if err := r.RasaXClient.WaitForDatabaseMigration(ctx); err != nil {
+	if err := r.withProgress(func() error { return r.RasaXClient.WaitForDatabaseMigration(ctx) }); err != nil {
 		return err
 	}
 
-	msg := "Starting Rasa Server"
-	r.Spinner.Message(msg)
-	r.Log.Info(msg, "args", mutualArgs)
-	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-	ready := make(chan bool, 1)
-
-	go func() {
-		sig := <-sigs
-		fmt.Println()
-		fmt.Println(sig)
-		ready <- true
-	}()
-
-	go func(ready chan bool) {
-		done := make(chan bool, 1)
-		args := mutualArgs
-		args = append(args,
-			"-p",
-			fmt.Sprintf("%d", productionPort),
-		)
-		r.runRasaServer(environmentName, args, done, ready)
-		<-done
-	}(ready)
-
-	if r.Flags.ConnectRasa.RunSeparateWorker {
-		r.Log.Info("Running separate Rasa X server for the worker environment")
-		go func(ready chan bool) {
-			done := make(chan bool, 1)
-			args := mutualArgs
-			args = append(args,
-				"-p",
-				fmt.Sprintf("%d", workerPort),
-			)
-			r.runRasaServer("worker", args, done, ready)
-			<-done
-		}(ready)
+	servers := r.rasaServers()
+	if r.Flags.ConnectRasa.Docker {
+		return r.runRasaServerContainers(rasaImage, configDir, servers, mutualArgs)
 	}
-	r.Spinner.Stop()
-	<-ready
-	fmt.Println("exiting")
 
-	return nil
+	return r.superviseRasaServers(servers, mutualArgs, rasaToken)
 }
 
-func (r *RasaCtl) runRasaServer(environment string, args []string, done chan bool, ready chan bool) {
-
-	cmd := exec.Command("rasa", args...)
-	stdout, err := cmd.StderrPipe()
-	if err != nil {
-		panic(err)
+// rasaServers returns Rasa servers that run for the Rasa X environments.
+// A single server runs for both environments unless a separate worker is requested.
+func (r *RasaCtl) rasaServers() []rasaServer {
+	if !r.Flags.ConnectRasa.RunSeparateWorker {
+		return []rasaServer{{environment: "production-worker", port: r.Flags.ConnectRasa.Port}}
 	}
 
-	if err = cmd.Start(); err != nil {
-		panic(err)
+	r.Log.Info("Running separate Rasa X server for the worker environment")
+	return []rasaServer{
+		{environment: "production", port: r.Flags.ConnectRasa.Port},
+		{environment: "worker", port: r.Flags.ConnectRasa.Port + 1},
 	}
+}
 
-	// get real-time output
-	for {
-		tmp := make([]byte, 1024)
-		_, err := stdout.Read(tmp)
-		fmt.Printf("(%s) %s", environment, string(tmp))
-		if err != nil {
-			break
+// superviseRasaServers runs local Rasa servers and restarts them if they crash.
+// It waits until all servers are ready, and then blocks until rasactl is interrupted and all servers have stopped.
+func (r *RasaCtl) superviseRasaServers(servers []rasaServer, args []string, token string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The global signal handler doesn't exit rasactl until process groups of the servers are stopped.
+	sigs, stopSignals := utils.NotifySignals()
+	defer stopSignals()
+
+	msg := "Starting Rasa Server"
+	r.Spinner.Message(msg)
+
+	var wg sync.WaitGroup
+	processes := []*rasaServerProcess{}
+	for _, server := range servers {
+		process := newRasaServerProcess(server, args, token, r.Log)
+		processes = append(processes, process)
+		r.Log.Info(msg, "environment", server.environment, "args", process.args)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			process.supervise(ctx)
+		}()
+	}
+
+	stop := func() {
+		cancel()
+		wg.Wait()
+		fmt.Println("exiting")
+	}
+
+	r.Spinner.Message("Waiting for Rasa Server to be ready")
+	timeout := time.After(rasaServerReadyTimeout)
+	for _, process := range processes {
+		select {
+		case <-process.ready:
+		case <-timeout:
+			stop()
+			return xerrors.Errorf("Rasa server for the %s environment is not ready after %s", process.environment, rasaServerReadyTimeout)
+		case sig := <-sigs:
+			fmt.Println()
+			fmt.Println(sig)
+			stop()
+			return nil
 		}
 	}
+	r.Spinner.Stop()
+	fmt.Printf("Rasa Server is connected to the %s deployment, press Ctrl+C to stop it.\n", r.Namespace)
 
-	fmt.Println("awaiting signal")
-	<-ready
-	fmt.Println("exiting")
-	if err = cmd.Wait(); err != nil {
-		panic(err)
-	}
-	fmt.Println("done", cmd.ProcessState)
-	done <- true
+	sig := <-sigs
+	fmt.Println()
+	fmt.Println(sig)
+	stop()
 
+	return nil
 }
 
 func (r *RasaCtl) getRasaXNodePortURL() (string, error) {
@@ -215,70 +246,122 @@ func (r *RasaCtl) getRasaXNodePortURL() (string, error) {
 	return url, nil
 }
 
-func (r *RasaCtl) saveRasaCredentialsFile(file string) error {
+// rasaServerHost returns the address under which the Rasa server
+// reaches services exposed by the kind cluster.
+func (r *RasaCtl) rasaServerHost() string {
+	if r.Flags.ConnectRasa.Docker && runtime.GOOS != "linux" {
+		return "host.docker.internal"
+	}
+	return "127.0.0.1"
+}
+
+// rasaServerEndpoints stores addresses of Rasa X services used by the Rasa server.
+type rasaServerEndpoints struct {
+	rasaXURL       string
+	postgreSQLHost string
+	postgreSQLPort int32
+	rabbitMQHost   string
+	rabbitMQPort   int32
+}
+
+// nodePortEndpoints returns addresses of Rasa X services exposed via node ports of the kind cluster.
+func (r *RasaCtl) nodePortEndpoints() (rasaServerEndpoints, error) {
+	endpoints := rasaServerEndpoints{
+		postgreSQLHost: r.rasaServerHost(),
+		rabbitMQHost:   r.rasaServerHost(),
+	}
+
 	url, err := r.GetRasaXURL()
 	if err != nil {
-		return err
+		return endpoints, err
 	}
+	endpoints.rasaXURL = url
 
-	creds := rtypes.CredentialsFile{}
-	creds.Rasa.URL = fmt.Sprintf("%s/api", url)
+	// A container can't resolve the Rasa X hostname, so it uses the node port instead.
+	if r.Flags.ConnectRasa.Docker {
+		rasaXNodePort, err := r.KubernetesClient.GetRasaXSvcNodePort()
+		if err != nil {
+			return endpoints, err
+		}
+		endpoints.rasaXURL = fmt.Sprintf("http://%s:%d", r.rasaServerHost(), rasaXNodePort)
+	}
 
+	if endpoints.postgreSQLPort, err = r.KubernetesClient.GetPostgreSQLSvcNodePort(); err != nil {
+		return endpoints, err
+	}
+
+	if endpoints.rabbitMQPort, err = r.KubernetesClient.GetRabbitMqSvcNodePort(); err != nil {
+		return endpoints, err
+	}
+
+	if endpoints.postgreSQLPort == 0 || endpoints.rabbitMQPort == 0 {
+		return endpoints, xerrors.Errorf("PostgreSQL and RabbitMQ services are not exposed via node ports")
+	}
+
+	return endpoints, nil
+}
+
+func (r *RasaCtl) saveRasaCredentialsFile(file string, endpoints rasaServerEndpoints) error {
 	r.Log.Info("Saving credentials.yaml configuration file", "file", file)
 
-	data, err := yaml.Marshal(&creds)
+	data, err := r.rasaCredentialsFile(endpoints)
 	if err != nil {
 		return err
 	}
 	return ioutil.WriteFile(file, data, 0644)
 }
 
-func (r *RasaCtl) saveRasaEndpointsFile(file string) error {
-	url, err := r.GetRasaXURL()
-	if err != nil {
-		return err
-	}
+func (r *RasaCtl) saveRasaEndpointsFile(file string, endpoints rasaServerEndpoints) error {
+	r.Log.Info("Saving endpoints.yaml configuration file", "file", file)
 
-	token, err := r.GetRasaXToken()
+	data, err := r.rasaEndpointsFile(endpoints)
 	if err != nil {
 		return err
 	}
+	return ioutil.WriteFile(file, data, 0644)
+}
 
-	psqlNodePort, err := r.KubernetesClient.GetPostgreSQLSvcNodePort()
-	if err != nil {
-		return err
-	}
+// rasaCredentialsFile returns the content of the credentials.yaml file for Rasa OSS.
+func (r *RasaCtl) rasaCredentialsFile(endpoints rasaServerEndpoints) ([]byte, error) {
+	creds := rtypes.CredentialsFile{}
+	creds.Rasa.URL = fmt.Sprintf("%s/api", endpoints.rasaXURL)
+
+	return yaml.Marshal(&creds)
+}
 
-	rabbitNodePort, err := r.KubernetesClient.GetRabbitMqSvcNodePort()
+// rasaEndpointsFile returns the content of the endpoints.yaml file for Rasa OSS,
+// the model server, tracker store and event broker of the deployment are used.
+func (r *RasaCtl) rasaEndpointsFile(endpoints rasaServerEndpoints) ([]byte, error) {
+	token, err := r.GetRasaXToken()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	usernamePsql, passwordPsql, err := r.KubernetesClient.GetPostgreSQLCreds()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	usernameRabbit, passwordRabbit, err := r.KubernetesClient.GetRabbitMqCreds()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := r.GetAllHelmValues(); err != nil {
-		return err
+		return nil, err
 	}
 
-	endpoints := rtypes.EndpointsFile{
+	endpointsFile := rtypes.EndpointsFile{
 		Models: rtypes.EndpointModelSpec{
-			URL:                  fmt.Sprintf("%s/api/projects/default/models/tags/production", url),
+			URL:                  fmt.Sprintf("%s/api/projects/default/models/tags/production", endpoints.rasaXURL),
 			Token:                token,
 			WaitTimeBetweenPulls: 10,
 		},
 		TrackerStore: rtypes.EndpointTrackerStoreSpec{
 			Type:     "sql",
 			Dialect:  "postgresql",
-			URL:      "127.0.0.1",
-			Port:     psqlNodePort,
+			URL:      endpoints.postgreSQLHost,
+			Port:     endpoints.postgreSQLPort,
 			Username: usernamePsql,
 			Password: passwordPsql,
 			Db:       "tracker",
@@ -286,21 +369,15 @@ func (r *RasaCtl) saveRasaEndpointsFile(file string) error {
 		},
 		EventBroker: rtypes.EndpointEventBrokerSpec{
 			Type:     "pika",
-			URL:      "127.0.0.1",
-			Port:     rabbitNodePort,
+			URL:      endpoints.rabbitMQHost,
+			Port:     endpoints.rabbitMQPort,
 			Username: usernameRabbit,
 			Password: passwordRabbit,
 			Queues:   []string{r.HelmClient.GetValues()["rasa"].(map[string]interface{})["rabbitQueue"].(string)},
 		},
 	}
 
-	r.Log.Info("Saving endpoints.yaml configuration file", "file", file)
-
-	data, err := yaml.Marshal(&endpoints)
-	if err != nil {
-		return err
-	}
-	return ioutil.WriteFile(file, data, 0644)
+	return yaml.Marshal(&endpointsFile)
 }
 
 func (r *RasaCtl) saveEnvironments(token string) error {
@@ -335,6 +412,72 @@ func (r *RasaCtl) saveEnvironments(token string) error {
 	return r.RasaXClient.SaveEnvironments(configSpec)
 }
 
+// saveConnectRasaState stores the deployment configuration that is changed by ConnectRasa in the state secret,
+// so that it can be restored with DisconnectRasa. If the state already exists, it's not overwritten.
+func (r *RasaCtl) saveConnectRasaState(stateData map[string][]byte) error {
+	if _, ok := stateData[types.StateConnectRasa]; ok {
+		r.Log.V(1).Info("The configuration from before Rasa Server was connected is already stored")
+		return nil
+	}
+
+	// The values are read into a local variable, the values of the helm client
+	// are used by the upgrade and they can't be replaced with computed values.
+	values, err := r.HelmClient.GetAllValues()
+	if err != nil {
+		return err
+	}
+
+	rasaXConfig, err := r.KubernetesClient.GetRasaXConfigData()
+	if err != nil {
+		return err
+	}
+
+	environments, err := r.rasaXEnvironments(rasaXConfig)
+	if err != nil {
+		return err
+	}
+
+	return r.KubernetesClient.UpdateSecretWithState(types.ConnectRasaState{
+		Values:       helm.ValuesConnectRasaSnapshot(values),
+		RasaXConfig:  rasaXConfig,
+		Environments: environments,
+	})
+}
+
+// rasaXEnvironments returns Rasa X environments defined in the Rasa X configuration files.
+func (r *RasaCtl) rasaXEnvironments(rasaXConfig map[string]string) ([]rxtypes.EnvironmentsEndpointRequest, error) {
+	config := rxtypes.EnvironmentsConfigurationFile{}
+	if err := yaml.Unmarshal([]byte(rasaXConfig["environments"]), &config); err != nil {
+		return nil, err
+	}
+
+	rasaToken, err := r.KubernetesClient.GetRasaToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// The token is usually passed to Rasa X via an environment variable, e.g. ${RASA_TOKEN}.
+	token := func(t string) string {
+		if strings.HasPrefix(t, "${") {
+			return rasaToken
+		}
+		return t
+	}
+
+	return []rxtypes.EnvironmentsEndpointRequest{
+		{
+			Name:  "production",
+			URL:   config.Rasa.Production.URL,
+			Token: token(config.Rasa.Production.Token),
+		},
+		{
+			Name:  "worker",
+			URL:   config.Rasa.Worker.URL,
+			Token: token(config.Rasa.Worker.Token),
+		},
+	}, nil
+}
+
 func (r *RasaCtl) upgradeDeploymentConfiguration() error {
 
 	state, err := r.KubernetesClient.ReadSecretWithState()
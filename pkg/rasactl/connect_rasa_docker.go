@@ -0,0 +1,128 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/docker"
+	rtypes "github.com/RasaHQ/rasactl/pkg/types/rasa"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// rasaServerImage returns the Rasa OSS image that matches the Rasa version used by the deployment.
+func (r *RasaCtl) rasaServerImage() (string, error) {
+	version := r.Flags.ConnectRasa.RasaVersion
+
+	if version == "" {
+		r.initRasaXClient()
+		versionEndpoint, err := r.RasaXClient.GetVersionEndpoint()
+		if err != nil {
+			return "", err
+		}
+
+		for _, v := range []string{versionEndpoint.Rasa.Production, versionEndpoint.Rasa.Worker} {
+			if v != "" && v != "0.0.0" {
+				version = v
+				break
+			}
+		}
+	}
+
+	if version == "" {
+		return "", xerrors.Errorf("can't determine the Rasa version used by the deployment, use the --rasa-version flag to set it")
+	}
+
+	image := fmt.Sprintf("%s:%s", rtypes.ImageName, version)
+	r.Log.V(1).Info("Using Rasa image", "image", image)
+
+	return image, nil
+}
+
+// runRasaServerContainers runs Rasa servers in Docker containers and streams their logs
+// until rasactl is interrupted or one of the containers stops.
+// The containers are deleted on exit.
+func (r *RasaCtl) runRasaServerContainers(image, configDir string, servers []rasaServer, args []string) error {
+	// On Linux the host network is used, so the Rasa server can reach
+	// the node ports of the kind cluster the same way as a local process.
+	// On other systems the ports are published and services are reached via host.docker.internal.
+	hostNetwork := runtime.GOOS == "linux"
+
+	// The global signal handler doesn't exit rasactl until the containers are deleted.
+	sigs, stopSignals := utils.NotifySignals()
+	defer stopSignals()
+
+	if err := r.DockerClient.DeleteRasaServerContainers(); err != nil {
+		return err
+	}
+
+	containers := []string{}
+	defer func() {
+		for _, name := range containers {
+			r.Log.V(1).Info("Deleting container", "name", name)
+			if err := r.DockerClient.DeleteContainer(name); err != nil {
+				r.Log.Error(err, "Can't delete container", "name", name)
+			}
+		}
+	}()
+
+	msg := "Starting Rasa Server"
+	r.Spinner.Message(msg)
+
+	stopped := make(chan string, len(servers))
+	for _, server := range servers {
+		name := fmt.Sprintf("rasactl-%s-%s", r.Namespace, server.environment)
+		serverArgs := append(append([]string{}, args...), "-p", fmt.Sprintf("%d", server.port))
+
+		r.Log.Info(msg, "environment", server.environment, "image", image, "args", serverArgs)
+		if err := r.DockerClient.RunRasaServer(docker.RasaServerSpec{
+			Name:        name,
+			Image:       image,
+			Args:        serverArgs,
+			Port:        server.port,
+			Mounts:      []string{configDir},
+			HostNetwork: hostNetwork,
+		}); err != nil {
+			return err
+		}
+		containers = append(containers, name)
+
+		go func(name, environment string) {
+			out := utils.NewPrefixWriter(os.Stdout, fmt.Sprintf("(%s) ", environment))
+			if err := r.DockerClient.StreamContainerLogs(name, out, out); err != nil {
+				r.Log.Error(err, "Can't stream logs", "container", name)
+			}
+			out.Flush() //nolint:golint,errcheck
+			stopped <- environment
+		}(name, server.environment)
+	}
+	r.Spinner.Stop()
+
+	select {
+	case sig := <-sigs:
+		fmt.Println()
+		fmt.Println(sig)
+	case environment := <-stopped:
+		return xerrors.Errorf("the Rasa server for the %s environment has stopped", environment)
+	}
+	fmt.Println("exiting")
+
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+
+	"golang.org/x/xerrors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// portForwardEndpoints forwards local ports to PostgreSQL, RabbitMQ and Rasa X services
+// of the deployment and returns addresses of the tunnels. The tunnels are closed when stopCh is closed.
+func (r *RasaCtl) portForwardEndpoints(stopCh <-chan struct{}) (rasaServerEndpoints, error) {
+	endpoints := rasaServerEndpoints{
+		postgreSQLHost: "127.0.0.1",
+		rabbitMQHost:   "127.0.0.1",
+	}
+
+	if err := r.GetAllHelmValues(); err != nil {
+		return endpoints, err
+	}
+	releaseName := r.HelmClient.GetConfiguration().ReleaseName
+
+	rasaXService, err := r.getRasaXService()
+	if err != nil {
+		return endpoints, err
+	}
+
+	rabbitPort := r.HelmClient.GetValues()["rabbitmq"].(map[string]interface{})["service"].(map[string]interface{})["port"].(float64)
+
+	r.Spinner.Message("Forwarding ports to Rasa X services")
+
+	rasaXPort, err := r.KubernetesClient.PortForwardService(rasaXService.Name, 0, stopCh)
+	if err != nil {
+		return endpoints, err
+	}
+	endpoints.rasaXURL = fmt.Sprintf("http://127.0.0.1:%d", rasaXPort)
+
+	postgreSQLPort, err := r.KubernetesClient.PortForwardService(fmt.Sprintf("%s-postgresql", releaseName), 0, stopCh)
+	if err != nil {
+		return endpoints, err
+	}
+	endpoints.postgreSQLPort = int32(postgreSQLPort)
+
+	rabbitMQPort, err := r.KubernetesClient.PortForwardService(fmt.Sprintf("%s-rabbit", releaseName), int32(rabbitPort), stopCh)
+	if err != nil {
+		return endpoints, err
+	}
+	endpoints.rabbitMQPort = int32(rabbitMQPort)
+
+	r.Log.Info("Forwarding ports to Rasa X services", "rasaX", endpoints.rasaXURL,
+		"postgresql", endpoints.postgreSQLPort, "rabbitmq", endpoints.rabbitMQPort)
+
+	return endpoints, nil
+}
+
+// getRasaXService returns the Rasa X service of the deployment.
+func (r *RasaCtl) getRasaXService() (*v1.Service, error) {
+	releaseName := r.HelmClient.GetConfiguration().ReleaseName
+
+	// If a release name is different than "rasa-x" then a service name has
+	// a different pattern, use labels to find the Rasa X service.
+	services, err := r.KubernetesClient.GetServiceWithLabels(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app.kubernetes.io/component=rasa-x,app.kubernetes.io/instance=%s", releaseName),
+		Limit:         1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(services.Items) == 0 {
+		return nil, xerrors.Errorf("can't find the Rasa X service for the %s release", releaseName)
+	}
+
+	return &services.Items[0], nil
+}
@@ -0,0 +1,469 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+	"github.com/RasaHQ/rasactl/pkg/version"
+)
+
+// debugBundle stores files collected for a debug bundle.
+// Collection errors don't stop the collection, they are saved in the bundle instead,
+// as the bundle is the most useful if a deployment is broken.
+type debugBundle struct {
+	dir    string
+	files  map[string]string
+	errors []string
+}
+
+type debugBundlePod struct {
+	Name       string                    `json:"name"`
+	Phase      v1.PodPhase               `json:"phase"`
+	Condition  string                    `json:"condition"`
+	Node       string                    `json:"node"`
+	StartTime  string                    `json:"startTime,omitempty"`
+	Containers []debugBundlePodContainer `json:"containers"`
+}
+
+type debugBundlePodContainer struct {
+	Name         string `json:"name"`
+	Image        string `json:"image"`
+	Init         bool   `json:"init,omitempty"`
+	Ready        bool   `json:"ready"`
+	RestartCount int32  `json:"restartCount"`
+	State        string `json:"state"`
+	LastState    string `json:"lastState,omitempty"`
+}
+
+type debugBundleEvent struct {
+	LastSeen string `json:"lastSeen"`
+	Type     string `json:"type"`
+	Reason   string `json:"reason"`
+	Object   string `json:"object"`
+	Message  string `json:"message"`
+	Count    int32  `json:"count"`
+}
+
+type debugBundleNode struct {
+	Name             string            `json:"name"`
+	Roles            []string          `json:"roles"`
+	KubeletVersion   string            `json:"kubeletVersion"`
+	OSImage          string            `json:"osImage"`
+	ContainerRuntime string            `json:"containerRuntime"`
+	Conditions       map[string]string `json:"conditions"`
+	Capacity         map[string]string `json:"capacity"`
+	Allocatable      map[string]string `json:"allocatable"`
+}
+
+// DebugBundle creates an archive with information required to debug a deployment:
+// the helm release and redacted values, keys of the rasactl state, pods, namespace events,
+// cluster nodes, recent container logs, Rasa X health and version, and Docker and kind details.
+func (r *RasaCtl) DebugBundle() error {
+	file := r.Flags.Debug.Bundle.Output
+	if file == "" {
+		file = fmt.Sprintf("%s-debug-%s.tar.gz", r.Namespace, time.Now().Format("20060102-150405"))
+	}
+
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("rasactl-debug-%s-", r.Namespace))
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	bundle := &debugBundle{
+		dir:   tmpDir,
+		files: map[string]string{},
+	}
+
+	r.Spinner.Message("Collecting deployment metadata")
+	bundle.writeYAML(types.DebugBundleFileMetadata, types.DebugBundleMetadata{
+		Namespace:         r.Namespace,
+		CreatedAt:         time.Now(),
+		RasactlVersion:    version.VERSION,
+		OS:                runtime.GOOS,
+		Arch:              runtime.GOARCH,
+		KubernetesBackend: r.KubernetesClient.GetBackendType(),
+		CloudProvider:     r.KubernetesClient.GetCloudProvider().Name,
+	})
+
+	r.Spinner.Message("Collecting helm release")
+	r.collectDebugHelmRelease(bundle)
+
+	r.Spinner.Message("Collecting rasactl state")
+	if state, err := r.KubernetesClient.ReadSecretWithState(); err != nil {
+		bundle.addError("rasactl state", err)
+	} else {
+		keys := []string{}
+		for key := range state {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		bundle.writeYAML(types.DebugBundleFileStateKeys, keys)
+	}
+
+	r.Spinner.Message("Collecting pods and events")
+	r.collectDebugPods(bundle)
+	r.collectDebugEvents(bundle)
+	r.collectDebugNodes(bundle)
+
+	r.Spinner.Message("Collecting Rasa X status")
+	r.collectDebugRasaX(bundle)
+
+	r.Spinner.Message("Collecting Docker details")
+	r.collectDebugDocker(bundle)
+
+	if len(bundle.errors) != 0 {
+		bundle.writeFile(types.DebugBundleFileErrors, []byte(strings.Join(bundle.errors, "\n")+"\n"))
+	}
+
+	r.Spinner.Message("Creating archive")
+	r.Log.Info("Creating debug bundle archive", "file", file, "files", len(bundle.files))
+	if err := utils.CreateArchive(file, bundle.files); err != nil {
+		return err
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("Debug bundle for the %s deployment has been saved to %s\n", r.Namespace, file)
+	if len(bundle.errors) != 0 {
+		fmt.Printf("Some data couldn't be collected, see the %s file in the bundle for details.\n", types.DebugBundleFileErrors)
+	}
+
+	return nil
+}
+
+func (r *RasaCtl) collectDebugHelmRelease(bundle *debugBundle) {
+	release, err := r.HelmClient.GetStatus()
+	if err != nil {
+		bundle.addError("helm release", err)
+	} else {
+		summary := map[string]interface{}{
+			"name":        release.Name,
+			"namespace":   release.Namespace,
+			"revision":    release.Version,
+			"status":      release.Info.Status.String(),
+			"description": release.Info.Description,
+			"updated":     release.Info.LastDeployed.String(),
+		}
+		if release.Chart != nil && release.Chart.Metadata != nil {
+			summary["chart"] = release.Chart.Metadata.Name
+			summary["chartVersion"] = release.Chart.Metadata.Version
+			summary["appVersion"] = release.Chart.Metadata.AppVersion
+		}
+
+		if history, err := r.HelmClient.History(); err != nil {
+			bundle.addError("helm history", err)
+		} else {
+			revisions := []map[string]interface{}{}
+			for _, rel := range history {
+				revisions = append(revisions, map[string]interface{}{
+					"revision":    rel.Version,
+					"status":      rel.Info.Status.String(),
+					"description": rel.Info.Description,
+					"updated":     rel.Info.LastDeployed.String(),
+				})
+			}
+			summary["history"] = revisions
+		}
+		bundle.writeYAML(types.DebugBundleFileRelease, summary)
+	}
+
+	if err := r.GetAllHelmValues(); err != nil {
+		bundle.addError("helm values", err)
+		return
+	}
+	bundle.writeYAML(types.DebugBundleFileValues, utils.RedactValues(r.HelmClient.GetValues()))
+}
+
+func (r *RasaCtl) collectDebugPods(bundle *debugBundle) {
+	pods, err := r.KubernetesClient.GetPods()
+	if err != nil {
+		bundle.addError("pods", err)
+		return
+	}
+
+	summary := []debugBundlePod{}
+	for _, pod := range pods.Items {
+		p := debugBundlePod{
+			Name:      pod.Name,
+			Phase:     pod.Status.Phase,
+			Condition: r.KubernetesClient.PodStatus(pod.Status.Conditions),
+			Node:      pod.Spec.NodeName,
+		}
+		if pod.Status.StartTime != nil {
+			p.StartTime = pod.Status.StartTime.Format(time.RFC3339)
+		}
+
+		statuses := map[string]v1.ContainerStatus{}
+		for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			statuses[status.Name] = status
+		}
+
+		for _, container := range pod.Spec.InitContainers {
+			p.Containers = append(p.Containers, debugBundleContainer(container, statuses[container.Name], true))
+		}
+		for _, container := range pod.Spec.Containers {
+			p.Containers = append(p.Containers, debugBundleContainer(container, statuses[container.Name], false))
+		}
+		summary = append(summary, p)
+
+		r.Spinner.Message(fmt.Sprintf("Collecting logs for the %s pod", pod.Name))
+		for _, status := range statuses {
+			// Containers that haven't been started yet have no logs.
+			if status.ContainerID == "" && status.LastTerminationState.Terminated == nil {
+				continue
+			}
+			r.collectDebugLogs(bundle, pod.Name, status.Name, false)
+
+			if status.RestartCount != 0 {
+				r.collectDebugLogs(bundle, pod.Name, status.Name, true)
+			}
+		}
+	}
+
+	bundle.writeYAML(types.DebugBundleFilePods, summary)
+}
+
+func debugBundleContainer(container v1.Container, status v1.ContainerStatus, init bool) debugBundlePodContainer {
+	return debugBundlePodContainer{
+		Name:         container.Name,
+		Image:        container.Image,
+		Init:         init,
+		Ready:        status.Ready,
+		RestartCount: status.RestartCount,
+		State:        containerStateString(status.State),
+		LastState:    containerStateString(status.LastTerminationState),
+	}
+}
+
+// containerStateString returns a short description of a container state.
+func containerStateString(state v1.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return fmt.Sprintf("running since %s", state.Running.StartedAt.Format(time.RFC3339))
+	case state.Waiting != nil:
+		return strings.TrimSpace(fmt.Sprintf("waiting: %s %s", state.Waiting.Reason, state.Waiting.Message))
+	case state.Terminated != nil:
+		return strings.TrimSpace(fmt.Sprintf("terminated with exit code %d: %s %s",
+			state.Terminated.ExitCode, state.Terminated.Reason, state.Terminated.Message))
+	}
+	return ""
+}
+
+func (r *RasaCtl) collectDebugLogs(bundle *debugBundle, pod, container string, previous bool) {
+	name := path.Join(types.DebugBundleLogsDir, pod, container+".log")
+	if previous {
+		name = path.Join(types.DebugBundleLogsDir, pod, container+".previous.log")
+	}
+
+	opts := &v1.PodLogOptions{
+		Container: container,
+		Previous:  previous,
+	}
+	if r.Flags.Debug.Bundle.TailLines > 0 {
+		opts.TailLines = &r.Flags.Debug.Bundle.TailLines
+	}
+
+	logs, err := r.KubernetesClient.GetContainerLogsWithOptions(pod, opts).DoRaw(context.TODO())
+	if err != nil {
+		bundle.addError(name, err)
+		return
+	}
+	bundle.writeFile(name, logs)
+}
+
+func (r *RasaCtl) collectDebugEvents(bundle *debugBundle) {
+	events, err := r.KubernetesClient.GetEvents()
+	if err != nil {
+		bundle.addError("events", err)
+		return
+	}
+
+	sort.SliceStable(events.Items, func(i, j int) bool {
+		return eventTime(events.Items[i]).Before(eventTime(events.Items[j]))
+	})
+
+	summary := []debugBundleEvent{}
+	for _, event := range events.Items {
+		summary = append(summary, debugBundleEvent{
+			LastSeen: eventTime(event).Format(time.RFC3339),
+			Type:     event.Type,
+			Reason:   event.Reason,
+			Object:   fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+			Message:  event.Message,
+			Count:    event.Count,
+		})
+	}
+
+	bundle.writeYAML(types.DebugBundleFileEvents, summary)
+}
+
+// eventTime returns time when an event was seen for the last time.
+func eventTime(event v1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+func (r *RasaCtl) collectDebugNodes(bundle *debugBundle) {
+	nodes, err := r.KubernetesClient.GetNodes()
+	if err != nil {
+		bundle.addError("nodes", err)
+		return
+	}
+
+	summary := []debugBundleNode{}
+	for _, node := range nodes.Items {
+		n := debugBundleNode{
+			Name:             node.Name,
+			Roles:            []string{},
+			KubeletVersion:   node.Status.NodeInfo.KubeletVersion,
+			OSImage:          node.Status.NodeInfo.OSImage,
+			ContainerRuntime: node.Status.NodeInfo.ContainerRuntimeVersion,
+			Conditions:       map[string]string{},
+			Capacity:         map[string]string{},
+			Allocatable:      map[string]string{},
+		}
+
+		for label := range node.Labels {
+			if role := strings.TrimPrefix(label, "node-role.kubernetes.io/"); role != label {
+				n.Roles = append(n.Roles, role)
+			}
+		}
+		sort.Strings(n.Roles)
+
+		for _, condition := range node.Status.Conditions {
+			n.Conditions[string(condition.Type)] = string(condition.Status)
+		}
+		for resource, quantity := range node.Status.Capacity {
+			n.Capacity[string(resource)] = quantity.String()
+		}
+		for resource, quantity := range node.Status.Allocatable {
+			n.Allocatable[string(resource)] = quantity.String()
+		}
+
+		summary = append(summary, n)
+	}
+
+	bundle.writeYAML(types.DebugBundleFileNodes, summary)
+}
+
+func (r *RasaCtl) collectDebugRasaX(bundle *debugBundle) {
+	url, err := r.GetRasaXURL()
+	if err != nil {
+		bundle.addError("Rasa X URL", err)
+		return
+	}
+
+	r.initRasaXClient()
+	r.RasaXClient.URL = url
+
+	if health, err := r.RasaXClient.GetHealthEndpoint(); err != nil {
+		bundle.addError("Rasa X health endpoint", err)
+	} else {
+		bundle.writeJSON(types.DebugBundleFileRasaXHealth, health)
+	}
+
+	if versionEndpoint, err := r.RasaXClient.GetVersionEndpoint(); err != nil {
+		bundle.addError("Rasa X version endpoint", err)
+	} else {
+		bundle.writeJSON(types.DebugBundleFileRasaXVersion, versionEndpoint)
+	}
+}
+
+func (r *RasaCtl) collectDebugDocker(bundle *debugBundle) {
+	summary := map[string]interface{}{}
+
+	if serverVersion, err := r.DockerClient.GetServerVersion(); err != nil {
+		bundle.addError("Docker server version", err)
+	} else {
+		summary["serverVersion"] = serverVersion
+	}
+
+	if kind := r.DockerClient.GetKind(); kind.ControlPlaneHost != "" {
+		kindSummary := map[string]interface{}{
+			"controlPlaneHost":  kind.ControlPlaneHost,
+			"kubernetesVersion": kind.Version,
+		}
+
+		projectNode := fmt.Sprintf("kind-%s", r.Namespace)
+		if exists, err := r.DockerClient.IsKindNodeExist(projectNode); err != nil {
+			bundle.addError("kind node", err)
+		} else if exists {
+			kindSummary["projectNode"] = projectNode
+		}
+		summary["kind"] = kindSummary
+	}
+
+	bundle.writeYAML(types.DebugBundleFileDocker, summary)
+}
+
+func (b *debugBundle) addError(what string, err error) {
+	b.errors = append(b.errors, fmt.Sprintf("%s: %s", what, err))
+}
+
+func (b *debugBundle) writeYAML(name string, data interface{}) {
+	d, err := yaml.Marshal(data)
+	if err != nil {
+		b.addError(name, err)
+		return
+	}
+	b.writeFile(name, d)
+}
+
+func (b *debugBundle) writeJSON(name string, data interface{}) {
+	d, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		b.addError(name, err)
+		return
+	}
+	b.writeFile(name, append(d, '\n'))
+}
+
+// writeFile writes data to a file in the bundle, name is a path of the file within the bundle.
+func (b *debugBundle) writeFile(name string, data []byte) {
+	file := filepath.Join(b.dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		b.addError(name, err)
+		return
+	}
+
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		b.addError(name, err)
+		return
+	}
+	b.files[name] = file
+}
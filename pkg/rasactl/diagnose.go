@@ -0,0 +1,186 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/RasaHQ/rasactl/pkg/helm"
+	"github.com/RasaHQ/rasactl/pkg/status"
+)
+
+// diagnoseLogLines is the number of log lines included for a crash looping container.
+const diagnoseLogLines int64 = 5
+
+// diagnoseTimeout inspects pods, events and persistent volume claims of a deployment
+// if helm timed out waiting for the release, and prints found root causes in a red box.
+func (r *RasaCtl) diagnoseTimeout(err error) {
+	if !helm.IsTimeoutWaitForCondition(err) {
+		return
+	}
+
+	r.Spinner.Message("Looking for the reason of the failure")
+	causes, err := r.diagnoseRelease()
+	if err != nil {
+		r.Log.Info("Can't diagnose the deployment", "error", err)
+		return
+	}
+	r.Spinner.Stop()
+
+	for _, cause := range causes {
+		r.Log.Info("Deployment failure", "cause", cause)
+	}
+
+	if len(causes) == 0 {
+		status.RedBox(
+			"Deployment failed",
+			"No obvious root cause found.\nRun `rasactl debug bundle` to collect details about the deployment.",
+		)
+		return
+	}
+
+	status.RedBox("Deployment failed", strings.Join(causes, "\n\n"))
+}
+
+// diagnoseRelease returns descriptions of problems found for pods and persistent volume claims of a deployment.
+func (r *RasaCtl) diagnoseRelease() ([]string, error) {
+	pods, err := r.KubernetesClient.GetPods()
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.KubernetesClient.GetEvents()
+	if err != nil {
+		return nil, err
+	}
+	warnings := latestWarningEvents(events.Items)
+
+	causes := []string{}
+	for _, pod := range pods.Items {
+		causes = append(causes, r.diagnosePod(pod, warnings)...)
+	}
+
+	pvcs, err := r.KubernetesClient.GetPersistentVolumeClaims()
+	if err != nil {
+		return nil, err
+	}
+	for _, pvc := range pvcs.Items {
+		if pvc.Status.Phase == v1.ClaimBound {
+			continue
+		}
+		cause := fmt.Sprintf("Persistent volume claim %s is %s", pvc.Name, pvc.Status.Phase)
+		if pvc.Spec.StorageClassName != nil {
+			cause = fmt.Sprintf("%s (storage class: %s)", cause, *pvc.Spec.StorageClassName)
+		}
+		if event, ok := warnings["PersistentVolumeClaim/"+pvc.Name]; ok {
+			cause = fmt.Sprintf("%s: %s", cause, event.Message)
+		}
+		causes = append(causes, cause)
+	}
+
+	return causes, nil
+}
+
+// diagnosePod returns descriptions of problems found for a pod.
+func (r *RasaCtl) diagnosePod(pod v1.Pod, warnings map[string]v1.Event) []string {
+	causes := []string{}
+
+	if pod.Status.Phase == v1.PodPending {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type != v1.PodScheduled || condition.Status == v1.ConditionTrue {
+				continue
+			}
+			message := condition.Message
+			if event, ok := warnings["Pod/"+pod.Name]; ok && event.Reason == "FailedScheduling" {
+				message = event.Message
+			}
+			causes = append(causes, fmt.Sprintf("Pod %s can't be scheduled: %s", pod.Name, message))
+		}
+	}
+
+	containerStatuses := append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+	containerStatuses = append(containerStatuses, pod.Status.ContainerStatuses...)
+	for _, containerStatus := range containerStatuses {
+		waiting := containerStatus.State.Waiting
+		if waiting == nil {
+			continue
+		}
+
+		switch waiting.Reason {
+		case "ImagePullBackOff", "ErrImagePull", "InvalidImageName":
+			causes = append(causes, fmt.Sprintf("Pod %s can't pull the %s image: %s",
+				pod.Name, containerStatus.Image, waiting.Reason))
+		case "CreateContainerConfigError":
+			causes = append(causes, fmt.Sprintf("Pod %s, container %s can't be created: %s",
+				pod.Name, containerStatus.Name, waiting.Message))
+		case "CrashLoopBackOff":
+			cause := fmt.Sprintf("Pod %s, container %s is crash looping (restarts: %d)",
+				pod.Name, containerStatus.Name, containerStatus.RestartCount)
+			if logs := r.lastLogLines(pod.Name, containerStatus.Name); logs != "" {
+				cause = fmt.Sprintf("%s, last log lines:\n%s", cause, logs)
+			}
+			causes = append(causes, cause)
+		}
+	}
+
+	return causes
+}
+
+// lastLogLines returns the last log lines of a container's previous instance.
+func (r *RasaCtl) lastLogLines(pod, container string) string {
+	tailLines := diagnoseLogLines
+	logs, err := r.KubernetesClient.GetContainerLogsWithOptions(pod, &v1.PodLogOptions{
+		Container: container,
+		Previous:  true,
+		TailLines: &tailLines,
+	}).DoRaw(context.TODO())
+	if err != nil {
+		r.Log.V(1).Info("Can't get logs", "pod", pod, "container", container, "error", err)
+		return ""
+	}
+	if strings.TrimSpace(string(logs)) == "" {
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimRight(string(logs), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "  " + line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// latestWarningEvents returns the latest warning event for each involved object.
+// Keys have the "Kind/Name" form.
+func latestWarningEvents(events []v1.Event) map[string]v1.Event {
+	warnings := map[string]v1.Event{}
+	for _, event := range events {
+		if event.Type != v1.EventTypeWarning {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name)
+		if latest, ok := warnings[key]; ok && eventTime(latest).After(eventTime(event)) {
+			continue
+		}
+		warnings[key] = event
+	}
+
+	return warnings
+}
@@ -0,0 +1,60 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+// DisconnectActionServer restores the configuration stored by ConnectActionServer,
+// e.g. if the 'connect action-server' command has been killed before it could restore it.
+func (r *RasaCtl) DisconnectActionServer() error {
+	stateData, err := r.KubernetesClient.ReadSecretWithState()
+	if err != nil {
+		return err
+	}
+
+	data, ok := stateData[types.StateConnectActionServer]
+	if !ok {
+		return xerrors.Errorf("The %s deployment has no configuration stored by the 'connect action-server' command, nothing to revert", r.Namespace)
+	}
+
+	state := types.ConnectActionServerState{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return xerrors.Errorf("can't read the configuration stored by the 'connect action-server' command: %w", err)
+	}
+
+	// Set configuration for helm client
+	helmConfig := r.HelmClient.GetConfiguration()
+	helmConfig.Version = string(stateData[types.StateHelmChartVersion])
+	helmConfig.ReleaseName = string(stateData[types.StateHelmReleaseName])
+	r.HelmClient.SetConfiguration(helmConfig)
+
+	r.Spinner.Message("Disconnecting the action server from Rasa X")
+	if err := r.disconnectActionServer(state.Values); err != nil {
+		return err
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("The action server has been disconnected from the %s deployment.\n", r.Namespace)
+
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/helm"
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// DisconnectRasa reverts changes made to a given deployment by ConnectRasa.
+func (r *RasaCtl) DisconnectRasa() error {
+	stateData, err := r.KubernetesClient.ReadSecretWithState()
+	if err != nil {
+		return err
+	}
+
+	data, ok := stateData[types.StateConnectRasa]
+	if !ok {
+		return xerrors.Errorf("The %s deployment has no configuration stored by the 'connect rasa' command, nothing to revert", r.Namespace)
+	}
+
+	state := types.ConnectRasaState{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return xerrors.Errorf("can't read the configuration stored by the 'connect rasa' command: %w", err)
+	}
+
+	r.Spinner.Message("Disconnecting Rasa Server from Rasa X")
+	r.Log.Info("Disconnecting Rasa Server from Rasa X")
+
+	// Set configuration for helm client
+	helmConfig := r.HelmClient.GetConfiguration()
+	helmConfig.Version = string(stateData[types.StateHelmChartVersion])
+	helmConfig.ReleaseName = string(stateData[types.StateHelmReleaseName])
+	r.HelmClient.SetConfiguration(helmConfig)
+
+	if err := r.GetAllHelmValues(); err != nil {
+		return err
+	}
+
+	r.HelmClient.SetValues(helm.RestoreConnectRasaSnapshot(r.HelmClient.GetValues(), state.Values))
+	r.Log.V(1).Info("Restoring values", "values", state.Values)
+
+	r.Log.Info("Upgrading configuration for Rasa X deployment", "step", "restore values from before Rasa Server was connected")
+	if err := r.HelmClient.Upgrade(); err != nil {
+		return err
+	}
+
+	if err := r.restoreRasaXConfig(state); err != nil {
+		return err
+	}
+
+	if err := r.KubernetesClient.DeleteSecretWithStateKeys(types.StateConnectRasa); err != nil {
+		return err
+	}
+
+	r.deleteRasaServerConfigFiles(string(stateData[types.StateProjectPath]))
+	r.Spinner.Stop()
+	fmt.Printf("Rasa Server has been disconnected from the %s deployment.\n", r.Namespace)
+
+	return nil
+}
+
+// restoreRasaXConfig restores the Rasa X environments configuration from before Rasa Server was connected.
+func (r *RasaCtl) restoreRasaXConfig(state types.ConnectRasaState) error {
+	r.initRasaXClient()
+	if err := r.RasaXClient.WaitForRasaX(); err != nil {
+		return err
+	}
+
+	version, err := r.RasaXClient.GetVersionEndpoint()
+	if err != nil {
+		return err
+	}
+
+	if version.Enterprise && utils.RasaXVersionConstrains(version.RasaX, ">= 1.0.0") {
+		r.Log.Info("Rasa Enterprise is active, resetting environments via the environment endpoint")
+
+		token, err := r.getAuthToken()
+		if err != nil {
+			return err
+		}
+		r.RasaXClient.BearerToken = token
+
+		return r.RasaXClient.SaveEnvironments(state.Environments)
+	}
+
+	r.Log.Info("Restoring configuration for Rasa X")
+	if err := r.KubernetesClient.SetRasaXConfigData(state.RasaXConfig); err != nil {
+		return err
+	}
+
+	r.Log.Info("Restarting Rasa X pod")
+	return r.KubernetesClient.DeleteRasaXPods()
+}
+
+// deleteRasaServerConfigFiles deletes configuration files generated for a local Rasa server.
+func (r *RasaCtl) deleteRasaServerConfigFiles(projectPath string) {
+	configDir := projectPath
+	if configDir == "" {
+		configDir = fmt.Sprintf("/tmp/rasactl-%s", r.Namespace)
+	}
+
+	for _, file := range []string{".credentials.yaml", ".endpoints.yaml"} {
+		path := fmt.Sprintf("%s/%s", configDir, file)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			r.Log.Info("Can't delete the configuration file", "file", path, "error", err.Error())
+		}
+	}
+}
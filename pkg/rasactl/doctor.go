@@ -0,0 +1,446 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"golang.org/x/xerrors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/RasaHQ/rasactl/pkg/credentials"
+	"github.com/RasaHQ/rasactl/pkg/credentials/helpers"
+	"github.com/RasaHQ/rasactl/pkg/docker"
+	"github.com/RasaHQ/rasactl/pkg/status"
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// check is a diagnostic check.
+type check func() types.CheckResult
+
+// Doctor runs all diagnostic checks and prints their results.
+// It returns an error if at least one check has failed.
+func (r *RasaCtl) Doctor() error {
+	results := r.runChecks([]check{
+		r.checkDocker,
+		r.checkKind,
+		r.checkCoreDNS,
+		r.checkIngressController,
+		r.checkStorageClass,
+		r.checkNodeResources,
+		r.checkRasaCommand,
+		r.checkCredentialHelper,
+	})
+
+	r.Spinner.Stop()
+	status.PrintCheckResults(results)
+
+	if failed := countFailedChecks(results); failed != 0 {
+		return xerrors.Errorf("%d of %d checks have failed", failed, len(results))
+	}
+
+	return nil
+}
+
+// preflight runs checks that detect problems which would make a deployment fail to start.
+// Only checks that haven't passed are printed.
+func (r *RasaCtl) preflight() error {
+	r.Spinner.Message("Running preflight checks")
+	results := r.runChecks([]check{
+		r.checkDocker,
+		r.checkKind,
+		r.checkCoreDNS,
+		r.checkIngressController,
+		r.checkStorageClass,
+		r.checkNodeResources,
+	})
+
+	problems := []types.CheckResult{}
+	for _, result := range results {
+		if result.Status != types.CheckStatusPass {
+			problems = append(problems, result)
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	r.Spinner.Stop()
+	status.PrintCheckResults(problems)
+	fmt.Println()
+
+	if countFailedChecks(problems) != 0 {
+		return xerrors.Errorf("preflight checks have failed, fix the problems above or use the --skip-preflight-checks flag")
+	}
+
+	return nil
+}
+
+func (r *RasaCtl) runChecks(checks []check) []types.CheckResult {
+	results := []types.CheckResult{}
+	for _, c := range checks {
+		result := c()
+		r.Log.V(1).Info("Check has finished", "name", result.Name, "status", result.Status, "message", result.Message)
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func countFailedChecks(results []types.CheckResult) int {
+	failed := 0
+	for _, result := range results {
+		if result.Status == types.CheckStatusFail {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+func (r *RasaCtl) isKindCluster() bool {
+	return r.DockerClient.GetKind().ControlPlaneHost != ""
+}
+
+func (r *RasaCtl) isProjectRequested() bool {
+	return r.Flags.Start.Project || r.Flags.Start.ProjectPath != ""
+}
+
+// isLocalIngressUsed returns true if Rasa X is exposed with an ingress under the rasactl.localhost domain.
+func (r *RasaCtl) isLocalIngressUsed() bool {
+	return r.KubernetesClient.GetBackendType() == types.KubernetesBackendLocal &&
+		r.KubernetesClient.GetCloudProvider().Name == types.CloudProviderUnknown
+}
+
+func (r *RasaCtl) checkDocker() types.CheckResult {
+	result := types.CheckResult{Name: "Docker"}
+	r.Spinner.Message("Checking Docker")
+
+	version, err := r.DockerClient.GetServerVersion()
+	if err != nil {
+		result.Status = types.CheckStatusFail
+		result.Message = fmt.Sprintf("can't get the Docker version: %s", err)
+		result.Hint = "make sure that Docker is installed and the Docker daemon is running"
+		return result
+	}
+
+	if err := docker.VersionConstrains(version); err != nil {
+		result.Status = types.CheckStatusFail
+		if docker.SkipVersionConstrainsCheck() {
+			result.Status = types.CheckStatusWarn
+		}
+		result.Message = err.Error()
+		result.Hint = "upgrade Docker, or set the RASACTL_SKIP_DOCKER_VERSION_CHECK=true environment variable to skip the check"
+		return result
+	}
+
+	result.Status = types.CheckStatusPass
+	result.Message = fmt.Sprintf("version %s", version)
+	return result
+}
+
+func (r *RasaCtl) checkKind() types.CheckResult {
+	result := types.CheckResult{Name: "kind"}
+
+	if r.isKindCluster() {
+		kind := r.DockerClient.GetKind()
+		result.Status = types.CheckStatusPass
+		result.Message = fmt.Sprintf("control plane node %s, Kubernetes %s", kind.ControlPlaneHost, kind.Version)
+		return result
+	}
+
+	if r.isProjectRequested() {
+		result.Status = types.CheckStatusFail
+		result.Message = "the current Kubernetes context doesn't use kind, a deployment with a local Rasa project requires kind"
+		result.Hint = "create a kind cluster with 'rasactl cluster create' or choose a kind cluster with the --kube-context flag"
+		return result
+	}
+
+	result.Status = types.CheckStatusPass
+	result.Message = "the current Kubernetes context doesn't use kind, the --project flag is not available"
+	return result
+}
+
+func (r *RasaCtl) checkCoreDNS() types.CheckResult {
+	result := types.CheckResult{Name: "CoreDNS"}
+
+	if !r.isKindCluster() || !r.isLocalIngressUsed() {
+		result.Status = types.CheckStatusPass
+		result.Message = fmt.Sprintf("the %s zone is not required for this cluster", types.RasaCtlLocalDomain)
+		return result
+	}
+
+	r.Spinner.Message("Checking CoreDNS")
+	hint := fmt.Sprintf("run 'rasactl cluster create --name %s' to add the %s zone to CoreDNS",
+		strings.TrimSuffix(r.DockerClient.GetKind().ControlPlaneHost, "-control-plane"), types.RasaCtlLocalDomain)
+
+	host := fmt.Sprintf("rasactl-doctor.%s", types.RasaCtlLocalDomain)
+	addresses, err := r.KubernetesClient.LookupHostInCluster(host)
+	if err != nil {
+		result.Status = types.CheckStatusWarn
+		result.Message = fmt.Sprintf("*.%s addresses are not resolved inside the cluster: %s", types.RasaCtlLocalDomain, err)
+		result.Hint = hint
+		return result
+	}
+
+	for _, address := range addresses {
+		if ip := net.ParseIP(address); ip == nil || !ip.IsLoopback() {
+			result.Status = types.CheckStatusWarn
+			result.Message = fmt.Sprintf("%s is resolved to %s inside the cluster, expected a loopback address",
+				host, strings.Join(addresses, ", "))
+			result.Hint = hint
+			return result
+		}
+	}
+
+	result.Status = types.CheckStatusPass
+	result.Message = fmt.Sprintf("*.%s addresses are resolved inside the cluster", types.RasaCtlLocalDomain)
+	return result
+}
+
+func (r *RasaCtl) checkIngressController() types.CheckResult {
+	result := types.CheckResult{Name: "Ingress controller"}
+
+	if !r.isLocalIngressUsed() {
+		result.Status = types.CheckStatusPass
+		result.Message = "not required, Rasa X is exposed with a service"
+		return result
+	}
+
+	r.Spinner.Message("Checking the ingress controller")
+	installed, err := r.KubernetesClient.IsIngressControllerInstalled()
+	if err != nil {
+		result.Status = types.CheckStatusWarn
+		result.Message = fmt.Sprintf("can't check ingress classes: %s", err)
+		return result
+	}
+
+	if !installed {
+		result.Status = types.CheckStatusWarn
+		result.Message = "no ingress controller has been found, Rasa X won't be accessible without one"
+		result.Hint = "install an ingress controller, e.g. ingress-nginx"
+		if r.isKindCluster() {
+			result.Hint = fmt.Sprintf("run 'rasactl cluster create --name %s' to install the ingress-nginx controller",
+				strings.TrimSuffix(r.DockerClient.GetKind().ControlPlaneHost, "-control-plane"))
+		}
+		return result
+	}
+
+	result.Status = types.CheckStatusPass
+	result.Message = "installed"
+	return result
+}
+
+func (r *RasaCtl) checkStorageClass() types.CheckResult {
+	result := types.CheckResult{Name: "Storage class"}
+	r.Spinner.Message("Checking storage classes")
+
+	class, err := r.KubernetesClient.GetDefaultStorageClass()
+	if err != nil {
+		result.Status = types.CheckStatusWarn
+		result.Message = fmt.Sprintf("can't check storage classes: %s", err)
+		return result
+	}
+
+	if class == "" {
+		result.Status = types.CheckStatusFail
+		result.Message = "there is no default storage class, volumes for PostgreSQL, RabbitMQ and Redis can't be provisioned"
+		result.Hint = "mark a storage class as default, " +
+			"see https://kubernetes.io/docs/tasks/administer-cluster/change-default-storage-class/"
+		return result
+	}
+
+	result.Status = types.CheckStatusPass
+	result.Message = fmt.Sprintf("the default storage class is %s", class)
+	return result
+}
+
+func (r *RasaCtl) checkNodeResources() types.CheckResult {
+	result := types.CheckResult{Name: "Node resources"}
+
+	if r.isProjectRequested() && r.isKindCluster() {
+		result.Status = types.CheckStatusPass
+		result.Message = "a dedicated kind node is created for the deployment"
+		return result
+	}
+
+	r.Spinner.Message("Checking node resources")
+	free, err := r.KubernetesClient.GetFreeNodeResources()
+	if err != nil {
+		result.Status = types.CheckStatusWarn
+		result.Message = fmt.Sprintf("can't check node resources: %s", err)
+		return result
+	}
+
+	if len(free) == 0 {
+		result.Status = types.CheckStatusFail
+		result.Message = "there is no ready node that pods can be scheduled on"
+		result.Hint = "check nodes with 'kubectl get nodes'"
+		return result
+	}
+
+	node := largestNode(free)
+	freeCPU, freeMemory := free[node][v1.ResourceCPU], free[node][v1.ResourceMemory]
+	available := fmt.Sprintf("%s CPU and %s memory available on the %s node",
+		formatCPU(freeCPU), formatMemory(freeMemory), node)
+
+	if running, err := r.KubernetesClient.IsRasaXRunning(); err == nil && running {
+		result.Status = types.CheckStatusPass
+		result.Message = fmt.Sprintf("the deployment is running, %s", available)
+		return result
+	}
+
+	manifest, err := r.chartManifest()
+	if err != nil {
+		result.Status = types.CheckStatusWarn
+		result.Message = fmt.Sprintf("can't render the helm chart: %s", err)
+		return result
+	}
+
+	requests, err := utils.ManifestResourceRequests(manifest)
+	if err != nil {
+		result.Status = types.CheckStatusWarn
+		result.Message = fmt.Sprintf("can't read resource requests of the helm chart: %s", err)
+		return result
+	}
+	requestedCPU, requestedMemory := requests[v1.ResourceCPU], requests[v1.ResourceMemory]
+	requested := fmt.Sprintf("the deployment requests %s CPU and %s memory", formatCPU(requestedCPU), formatMemory(requestedMemory))
+
+	// Pods of the deployment can be spread across nodes, but the check is strict and
+	// expects that all of them fit on a single node, which is the case for kind and
+	// other local clusters.
+	for name, resources := range free {
+		if requestedCPU.Cmp(resources[v1.ResourceCPU]) <= 0 && requestedMemory.Cmp(resources[v1.ResourceMemory]) <= 0 {
+			result.Status = types.CheckStatusPass
+			result.Message = fmt.Sprintf("%s, the %s node has %s CPU and %s memory available", requested, name,
+				formatCPU(resources[v1.ResourceCPU]), formatMemory(resources[v1.ResourceMemory]))
+			if requestedCPU.IsZero() && requestedMemory.IsZero() {
+				result.Message = available
+			}
+			return result
+		}
+	}
+
+	result.Status = types.CheckStatusFail
+	result.Message = fmt.Sprintf("%s, there is no node with enough resources, the largest one has %s", requested, available)
+	result.Hint = "stop other deployments, add nodes to the cluster or increase resources available to Docker"
+	return result
+}
+
+// largestNode returns a name of the node with the most free memory,
+// CPU is compared if nodes have the same amount of free memory.
+func largestNode(free map[string]v1.ResourceList) string {
+	largest := ""
+	for name, resources := range free {
+		if largest == "" {
+			largest = name
+			continue
+		}
+
+		memory, largestMemory := resources[v1.ResourceMemory], free[largest][v1.ResourceMemory]
+		cpu, largestCPU := resources[v1.ResourceCPU], free[largest][v1.ResourceCPU]
+		if c := memory.Cmp(largestMemory); c > 0 || (c == 0 && cpu.Cmp(largestCPU) > 0) {
+			largest = name
+		}
+	}
+
+	return largest
+}
+
+func formatCPU(q resource.Quantity) string {
+	return fmt.Sprintf("%.2f", float64(q.MilliValue())/1000)
+}
+
+func formatMemory(q resource.Quantity) string {
+	return fmt.Sprintf("%.2fGi", float64(q.Value())/(1<<30))
+}
+
+// chartManifest returns the manifest of the helm release, or the manifest rendered
+// by an installation dry run if the release is not deployed.
+func (r *RasaCtl) chartManifest() (string, error) {
+	deployed, err := r.HelmClient.IsDeployed()
+	if err != nil {
+		return "", err
+	}
+
+	if deployed {
+		release, err := r.HelmClient.GetStatus()
+		if err != nil {
+			return "", err
+		}
+		return release.Manifest, nil
+	}
+
+	// The dry run merges default values, values of the client are restored,
+	// so that the dry run doesn't affect the installation.
+	values := r.HelmClient.GetValues()
+	defer r.HelmClient.SetValues(values)
+
+	release, err := r.HelmClient.InstallDryRun()
+	if err != nil {
+		return "", err
+	}
+	return release.Manifest, nil
+}
+
+func (r *RasaCtl) checkRasaCommand() types.CheckResult {
+	result := types.CheckResult{Name: "Rasa OSS"}
+
+	if !utils.CommandExists("rasa") {
+		result.Status = types.CheckStatusWarn
+		result.Message = "the rasa command is not found, it's required by 'rasactl connect rasa' unless the --docker flag is used"
+		result.Hint = "install Rasa Open Source, see https://rasa.com/docs/rasa/installation"
+		return result
+	}
+
+	result.Status = types.CheckStatusPass
+	result.Message = "the rasa command is available"
+	return result
+}
+
+func (r *RasaCtl) checkCredentialHelper() types.CheckResult {
+	result := types.CheckResult{Name: "Credential helper"}
+	r.Spinner.Message("Checking the credential helper")
+
+	credsStore := credentials.Credentials{
+		Namespace: "doctor",
+		Helper:    helpers.Helper,
+	}
+
+	err := credsStore.Set("rasactl-check", "rasactl", "rasactl")
+	if err == nil {
+		_, _, err = credsStore.Get("rasactl-check")
+		if deleteErr := credsStore.Delete("rasactl-check"); err == nil {
+			err = deleteErr
+		}
+	}
+
+	if err != nil {
+		result.Status = types.CheckStatusWarn
+		result.Message = fmt.Sprintf("the credential helper doesn't work, 'rasactl auth login' can't store credentials: %s", err)
+		result.Hint = helpers.SetupHint
+		return result
+	}
+
+	result.Status = types.CheckStatusPass
+	result.Message = "credentials can be stored"
+	return result
+}
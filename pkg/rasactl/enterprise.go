@@ -25,6 +25,14 @@ import (
 
 // EnterpriseActivate activates an Enterprise license.
 func (r *RasaCtl) EnterpriseActivate() error {
+	return r.enterpriseActivate(func() (string, error) {
+		return utils.ReadLicense(r.Flags)
+	})
+}
+
+// enterpriseActivate activates an Enterprise license, the readLicense function is called
+// only if the license is not active yet.
+func (r *RasaCtl) enterpriseActivate(readLicense func() (string, error)) error {
 	r.initRasaXClient()
 
 	version, err := r.RasaXClient.GetVersionEndpoint()
@@ -47,7 +55,7 @@ func (r *RasaCtl) EnterpriseActivate() error {
 	}
 	r.RasaXClient.BearerToken = token
 
-	license, err := utils.ReadLicense(r.Flags)
+	license, err := readLicense()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,141 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"strconv"
+
+	"helm.sh/helm/v3/pkg/release"
+
+	"github.com/RasaHQ/rasactl/pkg/status"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// History prints revisions of the helm release for a given deployment.
+func (r *RasaCtl) History() error {
+	releases, err := r.HelmClient.History()
+	if err != nil {
+		return err
+	}
+
+	data := [][]string{}
+	for _, rel := range releases {
+		data = append(data, []string{
+			strconv.Itoa(rel.Version),
+			rel.Info.LastDeployed.Format("2006-01-02 15:04:05"),
+			rel.Info.Status.String(),
+			rel.Chart.Metadata.Version,
+			rasaXVersionFromRelease(rel),
+			rel.Info.Description,
+		})
+	}
+
+	r.Spinner.Stop()
+	status.PrintTable(
+		[]string{"Revision", "Updated", "Status", "Chart version", "Rasa X version", "Description"},
+		data,
+	)
+
+	return nil
+}
+
+// Rollback rolls back a deployment to a given revision of the helm release.
+func (r *RasaCtl) Rollback() error {
+	if err := utils.ValidateName(r.HelmClient.GetNamespace()); err != nil {
+		return err
+	}
+
+	r.initRasaXClient()
+
+	if r.Flags.Rollback.Revision != 0 {
+		r.Spinner.Message(fmt.Sprintf("Rolling back Rasa X to the revision %d", r.Flags.Rollback.Revision))
+	} else {
+		r.Spinner.Message("Rolling back Rasa X to the previous revision")
+	}
+
+	// A deployment stopped with 'rasactl stop' stays scaled down after the rollback,
+	// Rasa X is not started, so there is nothing to wait for.
+	isStopped, err := r.KubernetesClient.IsScaledDown()
+	if err != nil {
+		return err
+	}
+
+	if err := r.withProgress(func() error { return r.HelmClient.Rollback(r.Flags.Rollback.Revision) }); err != nil {
+		r.diagnoseTimeout(err)
+		return err
+	}
+
+	if isStopped {
+		helmRelease, err := r.HelmClient.GetStatus()
+		if err != nil {
+			return err
+		}
+
+		if err := r.KubernetesClient.UpdateSecretWithState(helmRelease); err != nil {
+			return err
+		}
+
+		r.Spinner.Stop()
+		fmt.Printf("The %s deployment has been rolled back to the revision %d (helm chart version %s, Rasa X version %s).\n",
+			r.Namespace, helmRelease.Version, helmRelease.Chart.Metadata.Version, rasaXVersionFromRelease(helmRelease))
+		fmt.Printf("The deployment is still stopped, use 'rasactl start %s' to start it.\n", r.Namespace)
+
+		return nil
+	}
+
+	url, err := r.GetRasaXURL()
+	if err != nil {
+		return err
+	}
+	r.RasaXClient.URL = url
+
+	if err := r.withProgress(r.RasaXClient.WaitForRasaX); err != nil {
+		return err
+	}
+
+	rasaXVersion, err := r.RasaXClient.GetVersionEndpoint()
+	if err != nil {
+		return err
+	}
+
+	helmRelease, err := r.HelmClient.GetStatus()
+	if err != nil {
+		return err
+	}
+
+	if err := r.KubernetesClient.UpdateSecretWithState(rasaXVersion, helmRelease); err != nil {
+		return err
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("The %s deployment has been rolled back to the revision %d (helm chart version %s, Rasa X version %s).\n",
+		r.Namespace, helmRelease.Version, helmRelease.Chart.Metadata.Version, rasaXVersion.RasaX)
+
+	return nil
+}
+
+// rasaXVersionFromRelease returns a Rasa X version used by a helm release.
+// The version is taken from the rasax.tag value, or from the chart appVersion if the tag is not set.
+func rasaXVersionFromRelease(rel *release.Release) string {
+	if rasax, ok := rel.Config["rasax"].(map[string]interface{}); ok {
+		if tag, ok := rasax["tag"].(string); ok && tag != "" {
+			return tag
+		}
+	}
+
+	return rel.Chart.Metadata.AppVersion
+}
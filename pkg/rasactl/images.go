@@ -0,0 +1,144 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/xerrors"
+	"helm.sh/helm/v3/pkg/release"
+
+	"github.com/RasaHQ/rasactl/pkg/docker"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// PreloadImages renders the rasa-x helm chart with the current values, pulls images used by the chart
+// to the local Docker daemon, and loads them to the kind control plane and the kind node of the deployment.
+func (r *RasaCtl) PreloadImages() error {
+	images, nodes, err := r.preloadImages()
+	if err != nil {
+		return err
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("%d image(s) used by the %s deployment have been preloaded to: %s\n",
+		len(images), r.Namespace, strings.Join(nodes, ", "))
+
+	return nil
+}
+
+// preloadImages returns images that have been preloaded and kind nodes that the images have been loaded to.
+func (r *RasaCtl) preloadImages() ([]string, []string, error) {
+	controlPlane := r.DockerClient.GetKind().ControlPlaneHost
+	if controlPlane == "" {
+		return nil, nil, xerrors.Errorf("It looks like you don't use kind as a current Kubernetes context, images can be preloaded only to kind nodes")
+	}
+
+	r.Spinner.Message("Rendering the helm chart")
+	rel, err := r.renderRelease()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	images, err := utils.ImagesFromManifest(releaseManifest(rel))
+	if err != nil {
+		return nil, nil, err
+	}
+	r.Log.Info("Images used by the deployment", "images", images)
+
+	for _, image := range images {
+		r.Spinner.Message(fmt.Sprintf("Pulling the %s image", image))
+		if _, err := r.DockerClient.PullImage(image); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	nodes := []string{controlPlane}
+	kindNode := fmt.Sprintf("kind-%s", r.Namespace)
+	exists, err := r.DockerClient.IsKindNodeExist(kindNode)
+	if err != nil {
+		return nil, nil, err
+	}
+	if exists {
+		nodes = append(nodes, kindNode)
+	}
+
+	for _, node := range nodes {
+		missing, err := r.missingKindNodeImages(node, images)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(missing) == 0 {
+			r.Log.Info("All images are already loaded", "node", node)
+			continue
+		}
+
+		r.Spinner.Message(fmt.Sprintf("Loading %d image(s) to the %s node", len(missing), node))
+		if err := r.DockerClient.LoadImagesToKindNode(node, missing); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return images, nodes, nil
+}
+
+// renderRelease renders the rasa-x helm chart without applying it.
+// If the deployment exists, the chart is rendered with values of the current helm release.
+func (r *RasaCtl) renderRelease() (*release.Release, error) {
+	isDeployed, err := r.HelmClient.IsDeployed()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isDeployed {
+		return r.HelmClient.InstallDryRun()
+	}
+
+	helmConfig := r.HelmClient.GetConfiguration()
+	helmConfig.ReuseValues = true
+	r.HelmClient.SetConfiguration(helmConfig)
+
+	return r.HelmClient.UpgradeDryRun()
+}
+
+// missingKindNodeImages returns images that are not available in a given kind node.
+func (r *RasaCtl) missingKindNodeImages(node string, images []string) ([]string, error) {
+	nodeImages, err := r.DockerClient.GetKindNodeImages(node)
+	if err != nil {
+		return nil, err
+	}
+
+	available := map[string]bool{}
+	for _, image := range nodeImages {
+		available[image] = true
+	}
+
+	missing := []string{}
+	for _, image := range images {
+		name, err := docker.NormalizeImageName(image)
+		if err != nil {
+			return nil, err
+		}
+
+		if !available[name] {
+			missing = append(missing, image)
+		}
+	}
+
+	return missing, nil
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package rasactl
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
@@ -23,11 +24,26 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
 )
 
 func (r *RasaCtl) Logs(args []string) error {
 	pod := ""
 
+	filter, err := utils.NewLineFilter(r.Flags.Logs.Include, r.Flags.Logs.Exclude)
+	if err != nil {
+		return err
+	}
+
+	if r.Flags.Logs.MultiPod() {
+		if args[1] != "" {
+			return xerrors.Errorf("a pod name can't be used together with the --all, --component, --selector or --pod-query flags")
+		}
+		return r.logsMultiPod(filter)
+	}
+
 	surveyIconsOpts := survey.WithIcons(func(icons *survey.IconSet) {
 		icons.Question.Text = ""
 		icons.Help.Format = "magenta"
@@ -96,12 +112,11 @@ func (r *RasaCtl) Logs(args []string) error {
 	}
 	defer stream.Close()
 
+	reader := bufio.NewReader(stream)
 	for {
-		buf := make([]byte, 2000)
-		numBytes, err := stream.Read(buf)
-
-		if numBytes == 0 {
-			break
+		line, err := reader.ReadString('\n')
+		if line != "" && filter.Match(line) {
+			fmt.Print(line)
 		}
 
 		if errors.Is(err, io.EOF) {
@@ -110,8 +125,6 @@ func (r *RasaCtl) Logs(args []string) error {
 		if err != nil {
 			return err
 		}
-		msg := string(buf[:numBytes])
-		fmt.Print(msg)
 	}
 
 	return nil
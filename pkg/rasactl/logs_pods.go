@@ -0,0 +1,255 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"golang.org/x/xerrors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// podLogsColors are colors used to prefix log lines with pod and container names.
+var podLogsColors = []color.Attribute{
+	color.FgCyan, color.FgGreen, color.FgMagenta, color.FgYellow, color.FgBlue,
+	color.FgHiCyan, color.FgHiGreen, color.FgHiMagenta, color.FgHiYellow, color.FgHiBlue,
+}
+
+// podLogs streams logs from containers of multiple pods to a single output.
+type podLogs struct {
+	r        *RasaCtl
+	filter   *utils.LineFilter
+	podQuery *regexp.Regexp
+
+	out io.Writer
+	// outMu guards out, so that lines from different containers are not interleaved.
+	outMu sync.Mutex
+
+	mu sync.Mutex
+	// streams stores streamed containers by container ID,
+	// a restarted container gets a new ID and is streamed again.
+	streams map[string]podLogsStream
+	wg      sync.WaitGroup
+}
+
+type podLogsStream struct {
+	pod    string
+	cancel context.CancelFunc
+}
+
+// logsMultiPod streams logs from all pods of the deployment that match the --all, --component,
+// --selector and --pod-query flags. In the follow mode new pods are streamed as they appear.
+func (r *RasaCtl) logsMultiPod(filter *utils.LineFilter) error {
+	l := &podLogs{
+		r:       r,
+		filter:  filter,
+		out:     color.Output,
+		streams: map[string]podLogsStream{},
+	}
+
+	if r.Flags.Logs.PodQuery != "" {
+		podQuery, err := regexp.Compile(r.Flags.Logs.PodQuery)
+		if err != nil {
+			return xerrors.Errorf("invalid pod query %q: %w", r.Flags.Logs.PodQuery, err)
+		}
+		l.podQuery = podQuery
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The global signal handler doesn't exit rasactl until all log streams are closed.
+	sigs, stopSignals := utils.NotifySignals()
+	defer stopSignals()
+	go func() {
+		select {
+		case <-sigs:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if !r.Flags.Logs.Follow {
+		labels := fmt.Sprintf("app.kubernetes.io/instance=%s", r.HelmClient.GetConfiguration().ReleaseName)
+		if selector := r.podLogsSelector(); selector != "" {
+			labels = fmt.Sprintf("%s,%s", labels, selector)
+		}
+
+		pods, err := r.KubernetesClient.GetPodsWithLabels(metav1.ListOptions{LabelSelector: labels})
+		if err != nil {
+			return err
+		}
+
+		if len(pods.Items) == 0 {
+			fmt.Println("No pods match the given selection.")
+			return nil
+		}
+
+		for i := range pods.Items {
+			l.streamPod(ctx, &pods.Items[i])
+		}
+		l.wg.Wait()
+
+		return nil
+	}
+
+	// The watch is re-created if it's closed by the API server.
+	for ctx.Err() == nil {
+		watcher, err := r.KubernetesClient.WatchPods(ctx, r.podLogsSelector())
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			return err
+		}
+
+		for event := range watcher.ResultChan() {
+			pod, ok := event.Object.(*v1.Pod)
+			if !ok {
+				continue
+			}
+
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				l.streamPod(ctx, pod)
+			case watch.Deleted:
+				l.stopPod(pod.Name)
+			}
+		}
+		watcher.Stop()
+	}
+	l.wg.Wait()
+
+	return nil
+}
+
+// podLogsSelector returns a label selector built from the --component and --selector flags.
+func (r *RasaCtl) podLogsSelector() string {
+	selectors := []string{}
+
+	if len(r.Flags.Logs.Components) != 0 {
+		selectors = append(selectors,
+			fmt.Sprintf("app.kubernetes.io/component in (%s)", strings.Join(r.Flags.Logs.Components, ",")))
+	}
+
+	if r.Flags.Logs.Selector != "" {
+		selectors = append(selectors, r.Flags.Logs.Selector)
+	}
+
+	return strings.Join(selectors, ",")
+}
+
+// streamPod starts streaming logs from containers of a given pod that have been started
+// and are not streamed yet.
+func (l *podLogs) streamPod(ctx context.Context, pod *v1.Pod) {
+	if l.podQuery != nil && !l.podQuery.MatchString(pod.Name) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if l.r.Flags.Logs.Container != "" && status.Name != l.r.Flags.Logs.Container {
+			continue
+		}
+
+		// Containers that haven't been started yet have no logs.
+		if status.ContainerID == "" || status.State.Waiting != nil {
+			continue
+		}
+
+		if _, ok := l.streams[status.ContainerID]; ok {
+			continue
+		}
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		l.streams[status.ContainerID] = podLogsStream{pod: pod.Name, cancel: cancel}
+
+		l.wg.Add(1)
+		go func(pod, container string) {
+			defer l.wg.Done()
+			defer cancel()
+
+			if err := l.stream(streamCtx, pod, container); err != nil && streamCtx.Err() == nil {
+				l.r.Log.Error(err, "Can't stream logs", "pod", pod, "container", container)
+			}
+		}(pod.Name, status.Name)
+	}
+}
+
+// stopPod stops streaming logs from containers of a given pod.
+func (l *podLogs) stopPod(pod string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for id, stream := range l.streams {
+		if stream.pod == pod {
+			stream.cancel()
+			delete(l.streams, id)
+		}
+	}
+}
+
+// stream copies filtered log lines of a container to the output, each line is prefixed
+// with colored pod and container names.
+func (l *podLogs) stream(ctx context.Context, pod, container string) error {
+	logs, err := l.r.KubernetesClient.GetContainerLogs(pod, container).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	prefix := fmt.Sprintf("%s %s ", podLogsColor(pod).Sprint(pod), podLogsColor(container).Sprint(container))
+
+	reader := bufio.NewReader(logs)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" && l.filter.Match(line) {
+			l.outMu.Lock()
+			fmt.Fprint(l.out, prefix, strings.TrimSuffix(line, "\n"), "\n")
+			l.outMu.Unlock()
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// podLogsColor returns a color for a given name, the same name always gets the same color.
+func podLogsColor(name string) *color.Color {
+	hash := fnv.New32a()
+	hash.Write([]byte(name)) //nolint:errcheck
+
+	return color.New(podLogsColors[hash.Sum32()%uint32(len(podLogsColors))])
+}
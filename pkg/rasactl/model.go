@@ -17,13 +17,14 @@ package rasactl
 
 import (
 	"fmt"
-	"math"
+	"os"
 	"strings"
 	"time"
 
 	"golang.org/x/xerrors"
 
 	"github.com/RasaHQ/rasactl/pkg/status"
+	"github.com/RasaHQ/rasactl/pkg/utils"
 )
 
 func (r *RasaCtl) checkIfRasaOSSProductionIsConnected() error {
@@ -90,6 +91,10 @@ func (r *RasaCtl) ModelDownload() error {
 	}
 	r.RasaXClient.BearerToken = token
 
+	if r.Flags.Model.Download.All || r.Flags.Model.Download.Tag != "" {
+		return r.RasaXClient.ModelDownloadAll()
+	}
+
 	return r.RasaXClient.ModelDownload()
 }
 
@@ -132,7 +137,6 @@ func (r *RasaCtl) ModelList() error {
 	}
 
 	for _, model := range models.Models {
-		sec, dec := math.Modf(model.TrainedAt)
 		tags := "none"
 		if len(model.Tags) != 0 {
 			tags = strings.Join(model.Tags, ",")
@@ -143,7 +147,7 @@ func (r *RasaCtl) ModelList() error {
 			fmt.Sprintf("%t", model.IsCompatible),
 			tags,
 			model.Hash,
-			time.Unix(int64(sec), int64(dec*(1e9))).Format("02 Jan 06 15:04 MST"),
+			utils.ModelTrainedAt(model).Format("02 Jan 06 15:04 MST"),
 		})
 	}
 	status.PrintTable(
@@ -152,3 +156,91 @@ func (r *RasaCtl) ModelList() error {
 	)
 	return nil
 }
+
+// ModelPrune deletes models that match the prune rules defined by the command flags.
+// Decisions for all models are printed first, and models are deleted after confirmation.
+func (r *RasaCtl) ModelPrune() error {
+	flags := r.Flags.Model.Prune
+	if flags.Keep <= 0 && flags.OlderThan <= 0 {
+		return xerrors.Errorf("at least one of the --keep or --older-than flags has to be set")
+	}
+
+	if err := r.checkIfRasaOSSProductionIsConnected(); err != nil {
+		return err
+	}
+
+	token, err := r.getAuthToken()
+	if err != nil {
+		return err
+	}
+	r.RasaXClient.BearerToken = token
+
+	models, err := r.RasaXClient.ModelList()
+	if err != nil {
+		return err
+	}
+
+	decisions := utils.ModelsToPrune(
+		models.Models,
+		utils.ModelPruneRule{Keep: flags.Keep, OlderThan: flags.OlderThan, KeepTags: flags.KeepTags},
+		time.Now(),
+	)
+
+	data := [][]string{}
+	toDelete := []string{}
+	for _, decision := range decisions {
+		action := "keep"
+		if decision.Delete {
+			action = "delete"
+			toDelete = append(toDelete, decision.Model.Model)
+		}
+
+		tags := "none"
+		if len(decision.Model.Tags) != 0 {
+			tags = strings.Join(decision.Model.Tags, ",")
+		}
+		data = append(data, []string{
+			decision.Model.Model,
+			tags,
+			utils.ModelTrainedAt(decision.Model).Format("02 Jan 06 15:04 MST"),
+			action,
+			decision.Reason,
+		})
+	}
+
+	if len(toDelete) == 0 {
+		fmt.Println("Nothing to prune, all models are kept.")
+		return nil
+	}
+
+	status.PrintTable(
+		[]string{"Name", "Tags", "Trained At", "Action", "Reason"},
+		data,
+	)
+	fmt.Println()
+
+	if flags.DryRun {
+		fmt.Printf("%d model(s) would be deleted.\n", len(toDelete))
+		return nil
+	}
+
+	if !flags.Force {
+		confirmed, err := utils.AskForConfirmation(fmt.Sprintf("%d model(s) will be deleted, are you sure?", len(toDelete)), 5, os.Stdin)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	for _, model := range toDelete {
+		r.Log.Info("Deleting model", "model", model)
+		if err := r.RasaXClient.DeleteModel(model); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("%d model(s) have been deleted.\n", len(toDelete))
+	return nil
+}
@@ -0,0 +1,223 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/xerrors"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// watchedProjectFiles defines files in the project directory that trigger training.
+var watchedProjectFiles = []string{"domain.yml", "config.yml"}
+
+// watchedProjectDir defines a directory in the project directory that triggers training.
+const watchedProjectDir string = "data"
+
+// ModelWatch watches the project directory of a deployment, trains a model if training data,
+// the domain or the configuration change, and uploads the model to Rasa X.
+func (r *RasaCtl) ModelWatch() error {
+	stateData, err := r.KubernetesClient.ReadSecretWithState()
+	if err != nil {
+		return err
+	}
+
+	projectPath := string(stateData[types.StateProjectPath])
+	if projectPath == "" {
+		return xerrors.Errorf("The %s deployment doesn't use a local project, "+
+			"the command works only for deployments started with the --project flag", r.Namespace)
+	}
+
+	if err := r.checkIfRasaOSSProductionIsConnected(); err != nil {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(projectPath); err != nil {
+		return err
+	}
+	if err := watchDirRecursive(watcher, filepath.Join(projectPath, watchedProjectDir)); err != nil {
+		return err
+	}
+
+	// The global signal handler doesn't exit rasactl while the project directory is watched,
+	// so that the watcher is closed before the command returns.
+	signals, stopSignals := utils.NotifySignals()
+	defer stopSignals()
+
+	// Changes are debounced, so that saving several files at once triggers a single training.
+	debounce := time.NewTimer(r.Flags.Model.Watch.Debounce)
+	debounce.Stop()
+
+	fmt.Printf("Watching the %s project directory for changes, press Ctrl+C to stop.\n", projectPath)
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if !isWatchedProjectPath(projectPath, event.Name) {
+				continue
+			}
+			r.Log.V(1).Info("Detected a change in the project directory", "file", event.Name, "operation", event.Op.String())
+
+			// New directories in the data directory have to be watched too.
+			if event.Op&fsnotify.Create == fsnotify.Create {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err := watchDirRecursive(watcher, event.Name); err != nil {
+						return err
+					}
+				}
+			}
+			debounce.Reset(r.Flags.Model.Watch.Debounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			r.Log.Error(err, "Watching the project directory")
+		case <-debounce.C:
+			if err := r.trainAndUploadModel(projectPath); err != nil {
+				color.Red("%s", err)
+			}
+			fmt.Println("Waiting for changes...")
+		case <-signals:
+			fmt.Println("Stopping watching the project directory.")
+			return nil
+		}
+	}
+}
+
+// trainAndUploadModel trains a model in a given project directory, uploads the model to Rasa X,
+// and tags the model if the --tag flag is set.
+func (r *RasaCtl) trainAndUploadModel(projectPath string) error {
+	outputDir, err := ioutil.TempDir("", fmt.Sprintf("rasactl-%s-models-", r.Namespace))
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(outputDir)
+
+	fmt.Println("Training a model...")
+	cmd := exec.Command("rasa", "train", "--out", outputDir)
+	cmd.Dir = projectPath
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return xerrors.Errorf("can't train a model: %w", err)
+	}
+
+	modelFile, err := newestModelFile(outputDir)
+	if err != nil {
+		return err
+	}
+
+	token, err := r.getAuthToken()
+	if err != nil {
+		return err
+	}
+	r.RasaXClient.BearerToken = token
+
+	r.Flags.Model.Upload.File = modelFile
+	if err := r.RasaXClient.ModelUpload(); err != nil {
+		return err
+	}
+
+	if tag := r.Flags.Model.Watch.Tag; tag != "" {
+		r.Flags.Model.Tag.Model = strings.TrimSuffix(filepath.Base(modelFile), ".tar.gz")
+		r.Flags.Model.Tag.Name = tag
+		if err := r.RasaXClient.ModelTag(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watchDirRecursive adds a given directory and all its subdirectories to the watcher.
+// It does nothing if the directory doesn't exist.
+func watchDirRecursive(watcher *fsnotify.Watcher, dir string) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return watcher.Add(path)
+		}
+		return nil
+	})
+}
+
+// isWatchedProjectPath checks if a change of a given path should trigger training.
+func isWatchedProjectPath(projectPath, path string) bool {
+	rel, err := filepath.Rel(projectPath, path)
+	if err != nil {
+		return false
+	}
+
+	// Skip hidden and temporary files created by editors.
+	if base := filepath.Base(rel); strings.HasPrefix(base, ".") || strings.HasSuffix(base, "~") {
+		return false
+	}
+
+	for _, file := range watchedProjectFiles {
+		if rel == file {
+			return true
+		}
+	}
+
+	return rel == watchedProjectDir || strings.HasPrefix(rel, watchedProjectDir+string(filepath.Separator))
+}
+
+// newestModelFile returns the newest model file in a given directory.
+func newestModelFile(dir string) (string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.tar.gz"))
+	if err != nil {
+		return "", err
+	}
+
+	if len(files) == 0 {
+		return "", xerrors.Errorf("can't find a trained model in the %s directory", dir)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		iInfo, _ := os.Stat(files[i])
+		jInfo, _ := os.Stat(files[j])
+		return iInfo.ModTime().After(jInfo.ModTime())
+	})
+
+	return files[0], nil
+}
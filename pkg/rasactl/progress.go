@@ -0,0 +1,89 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/RasaHQ/rasactl/pkg/status"
+	rtypes "github.com/RasaHQ/rasactl/pkg/types/rasax"
+)
+
+// progressInterval defines how often the startup progress view is refreshed.
+const progressInterval = 2 * time.Second
+
+// withProgress executes a function that waits for a deployment and shows
+// the startup progress of the deployment components until the function returns.
+func (r *RasaCtl) withProgress(fn func() error) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		r.watchProgress(ctx)
+	}()
+
+	err := fn()
+	cancel()
+	<-done
+	r.Spinner.ClearDetails()
+
+	return err
+}
+
+// watchProgress refreshes the startup progress view until the context is done.
+func (r *RasaCtl) watchProgress(ctx context.Context) {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	last := ""
+	for {
+		lines := r.progressLines()
+		if current := strings.Join(lines, "\n"); current != last {
+			r.Log.V(1).Info("Deployment progress", "components", lines)
+			last = current
+		}
+		r.Spinner.Details(lines)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// progressLines returns lines of the startup progress view. The Rasa X health
+// is included only if the Rasa X client is initialized and the health endpoint is reachable.
+func (r *RasaCtl) progressLines() []string {
+	workloads, err := r.KubernetesClient.GetWorkloadsProgress()
+	if err != nil {
+		r.Log.V(1).Info("Can't get the deployment progress", "error", err)
+		return nil
+	}
+
+	var health *rtypes.HealthEndpointsResponse
+	if r.RasaXClient != nil && r.RasaXClient.URL != "" {
+		if health, err = r.RasaXClient.GetHealthEndpoint(); err != nil {
+			r.Log.V(1).Info("Can't get the Rasa X health", "error", err)
+			health = nil
+		}
+	}
+
+	return status.ProgressLines(workloads, health)
+}
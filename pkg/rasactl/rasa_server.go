@@ -0,0 +1,197 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+const (
+	// rasaServerMinBackoff is the time to wait before a crashed Rasa server is restarted for the first time.
+	rasaServerMinBackoff = time.Second
+	// rasaServerMaxBackoff is the maximum time to wait before a crashed Rasa server is restarted.
+	rasaServerMaxBackoff = time.Minute
+	// rasaServerStableRunTime is the time after which a running Rasa server is considered stable,
+	// the restart backoff is reset if a server crashes after that time.
+	rasaServerStableRunTime = time.Minute * 5
+	// rasaServerStopTimeout is the time to wait for a Rasa server to exit before it's killed.
+	rasaServerStopTimeout = time.Second * 15
+	// rasaServerReadyInterval is the interval between checks of the /status endpoint.
+	rasaServerReadyInterval = time.Second * 2
+	// rasaServerReadyTimeout is the time to wait for Rasa servers to be ready after they are started.
+	rasaServerReadyTimeout = time.Minute * 10
+)
+
+// rasaServer defines a Rasa server that runs for a Rasa X environment.
+type rasaServer struct {
+	environment string
+	port        int
+}
+
+// rasaServerProcess supervises a local Rasa server process.
+type rasaServerProcess struct {
+	rasaServer
+	args  []string
+	token string
+	out   *utils.PrefixWriter
+	log   logr.Logger
+
+	// ready is closed once the server responds to the /status endpoint for the first time.
+	ready     chan struct{}
+	readyOnce sync.Once
+}
+
+func newRasaServerProcess(server rasaServer, args []string, token string, log logr.Logger) *rasaServerProcess {
+	return &rasaServerProcess{
+		rasaServer: server,
+		args:       append(append([]string{}, args...), "-p", fmt.Sprintf("%d", server.port)),
+		token:      token,
+		out:        utils.NewPrefixWriter(os.Stdout, fmt.Sprintf("(%s) ", server.environment)),
+		log:        log.WithValues("environment", server.environment),
+		ready:      make(chan struct{}),
+	}
+}
+
+// supervise runs the Rasa server and restarts it with an exponential backoff every time it exits.
+// It returns once ctx is cancelled and the process has stopped.
+func (p *rasaServerProcess) supervise(ctx context.Context) {
+	defer p.out.Flush() //nolint:golint,errcheck
+
+	backoff := rasaServerMinBackoff
+	for {
+		started := time.Now()
+		err := p.run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if time.Since(started) > rasaServerStableRunTime {
+			backoff = rasaServerMinBackoff
+		}
+
+		if err != nil {
+			fmt.Fprintf(p.out, "Rasa server exited: %s, restarting in %s\n", err, backoff)
+		} else {
+			fmt.Fprintf(p.out, "Rasa server exited, restarting in %s\n", backoff)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > rasaServerMaxBackoff {
+			backoff = rasaServerMaxBackoff
+		}
+	}
+}
+
+// run starts the Rasa server and waits until it exits.
+// If ctx is cancelled, the process group of the server is interrupted
+// and killed if it doesn't exit within rasaServerStopTimeout.
+func (p *rasaServerProcess) run(ctx context.Context) error {
+	cmd := exec.Command("rasa", p.args...)
+	cmd.Stdout = p.out
+	cmd.Stderr = p.out
+	// Run the server in its own process group, so that it doesn't receive
+	// signals sent to rasactl and all its child processes can be stopped at once.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	p.log.V(1).Info("Starting Rasa server", "args", p.args)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+
+	readyCtx, cancelReady := context.WithCancel(ctx)
+	defer cancelReady()
+	go p.waitForReady(readyCtx)
+
+	select {
+	case err := <-exited:
+		return err
+	case <-ctx.Done():
+	}
+
+	p.log.V(1).Info("Stopping Rasa server", "pid", cmd.Process.Pid)
+	if err := syscall.Kill(-cmd.Process.Pid, syscall.SIGINT); err != nil {
+		p.log.V(1).Info("Can't interrupt Rasa server", "error", err.Error())
+	}
+
+	select {
+	case err := <-exited:
+		return err
+	case <-time.After(rasaServerStopTimeout):
+		p.log.Info("Rasa server didn't stop in time, killing it", "timeout", rasaServerStopTimeout)
+		if err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL); err != nil {
+			p.log.V(1).Info("Can't kill Rasa server", "error", err.Error())
+		}
+		return <-exited
+	}
+}
+
+// waitForReady polls the /status endpoint of the Rasa server until it responds successfully.
+func (p *rasaServerProcess) waitForReady(ctx context.Context) {
+	statusURL := fmt.Sprintf("http://127.0.0.1:%d/status?token=%s", p.port, url.QueryEscape(p.token))
+	client := &http.Client{Timeout: rasaServerReadyInterval}
+	ticker := time.NewTicker(rasaServerReadyInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, statusURL, nil)
+		if err != nil {
+			return
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			p.log.V(1).Info("Waiting for Rasa server to be ready", "error", err.Error())
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode == http.StatusOK {
+			fmt.Fprintf(p.out, "Rasa server is ready at http://127.0.0.1:%d\n", p.port)
+			p.readyOnce.Do(func() { close(p.ready) })
+			return
+		}
+		p.log.V(1).Info("Waiting for Rasa server to be ready", "status", resp.StatusCode)
+	}
+}
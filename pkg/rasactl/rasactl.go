@@ -66,7 +66,9 @@ type RasaCtl struct {
 
 // InitClients initializes clients.
 func (r *RasaCtl) InitClients() error {
-	r.Spinner = status.NewSpinner()
+	if r.Spinner == nil {
+		r.Spinner = status.NewSpinner()
+	}
 
 	cloudProvider := &cloud.Provider{Log: r.Log}
 	cloudProvider.New()
@@ -199,33 +201,44 @@ func (r *RasaCtl) useProject(projectPath string) error {
 }
 
 func (r *RasaCtl) start() error {
-	state, err := r.KubernetesClient.ReadSecretWithState()
-	if err != nil {
-		return err
-	}
 	// Start Rasa X if deployments are scaled down to 0
 	msg := "Starting Rasa X"
 	r.Spinner.Message(msg)
 	r.Log.Info(msg)
 
-	if string(state[types.StateProjectPath]) != "" && r.DockerClient.GetKind().ControlPlaneHost != "" {
-		nodeName := fmt.Sprintf("kind-%s", r.Namespace)
-		if err := r.DockerClient.StartKindNode(nodeName); err != nil {
-			return err
-		}
+	if err := r.startKindNode(); err != nil {
+		return err
 	}
 	// Set configuration used for starting a stopped project.
 	helmConfig := r.HelmClient.GetConfiguration()
 	helmConfig.StartProject = true
 	r.HelmClient.SetConfiguration(helmConfig)
 
-	if err := r.HelmClient.Upgrade(); err != nil {
+	if err := r.withProgress(r.HelmClient.Upgrade); err != nil {
+		r.diagnoseTimeout(err)
 		return err
 	}
 
 	return r.KubernetesClient.ScaleUp()
 }
 
+// startKindNode starts the kind node of a deployment that uses a local Rasa project.
+func (r *RasaCtl) startKindNode() error {
+	state, err := r.KubernetesClient.ReadSecretWithState()
+	if err != nil {
+		return err
+	}
+
+	if string(state[types.StateProjectPath]) != "" && r.DockerClient.GetKind().ControlPlaneHost != "" {
+		nodeName := fmt.Sprintf("kind-%s", r.Namespace)
+		if err := r.DockerClient.StartKindNode(nodeName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *RasaCtl) startOrInstall() error {
 	projectPath := r.Flags.Start.ProjectPath
 	// Install Rasa X
@@ -236,8 +249,15 @@ func (r *RasaCtl) startOrInstall() error {
 			return err
 		}
 
+		if r.Flags.Start.PreloadImages {
+			if _, _, err := r.preloadImages(); err != nil {
+				return err
+			}
+		}
+
 		r.Spinner.Message("Deploying Rasa X")
-		if err := r.HelmClient.Install(); err != nil {
+		if err := r.withProgress(r.HelmClient.Install); err != nil {
+			r.diagnoseTimeout(err)
 			return helm.ErrorTimeoutWaitForCondition(err)
 		}
 	} else if !r.isRasaXRunning {
@@ -295,7 +315,7 @@ func (r *RasaCtl) initRasaXClient() {
 }
 
 func (r *RasaCtl) checkDeploymentStatus() error {
-	err := r.RasaXClient.WaitForRasaX()
+	err := r.withProgress(r.RasaXClient.WaitForRasaX)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,260 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/xerrors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// Restore recreates a deployment from an archive created by the Backup method.
+// If the deployment doesn't exist, it's installed with helm values stored in the archive,
+// then the PostgreSQL database and models are restored.
+func (r *RasaCtl) Restore() error {
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("rasactl-restore-%s-", r.Namespace))
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	r.Spinner.Message("Reading backup archive")
+	files, err := utils.ExtractArchive(r.Flags.Restore.File, tmpDir)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{types.BackupFileMetadata, types.BackupFileState,
+		types.BackupFileValues, types.BackupFilePostgreSQL} {
+		if _, ok := files[name]; !ok {
+			return xerrors.Errorf("the %s file is not a valid backup, %s is missing", r.Flags.Restore.File, name)
+		}
+	}
+
+	metadata := types.BackupMetadata{}
+	if err := readYAMLFile(files[types.BackupFileMetadata], &metadata); err != nil {
+		return err
+	}
+
+	state := map[string]string{}
+	if err := readYAMLFile(files[types.BackupFileState], &state); err != nil {
+		return err
+	}
+
+	values := map[string]interface{}{}
+	if err := readYAMLFile(files[types.BackupFileValues], &values); err != nil {
+		return err
+	}
+
+	r.Log.Info("Restoring deployment", "file", r.Flags.Restore.File,
+		"sourceNamespace", metadata.Namespace, "namespace", r.Namespace, "createdAt", metadata.CreatedAt)
+
+	// The release name and the chart version from the backup are used only for a new deployment,
+	// an existing deployment keeps its own release.
+	releaseName, chartVersion := state[types.StateHelmReleaseName], state[types.StateHelmChartVersion]
+	isNew := !r.KubernetesClient.IsSecretWithStateExist()
+	if !isNew {
+		currentState, err := r.KubernetesClient.ReadSecretWithState()
+		if err != nil {
+			return err
+		}
+		releaseName, chartVersion = string(currentState[types.StateHelmReleaseName]), string(currentState[types.StateHelmChartVersion])
+	}
+
+	helmConfig := r.HelmClient.GetConfiguration()
+	helmConfig.ReleaseName = releaseName
+	if helmConfig.Version == "" {
+		helmConfig.Version = chartVersion
+	}
+	r.HelmClient.SetConfiguration(helmConfig)
+	r.KubernetesClient.SetHelmReleaseName(helmConfig.ReleaseName)
+
+	isDeployed, isRunning, err := r.CheckDeploymentStatus()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !isDeployed:
+		if isNew {
+			r.useBackupProjectPath(state[types.StateProjectPath])
+		}
+
+		r.HelmClient.SetValues(
+			removeNamespaceSpecificValues(values, metadata.Namespace, state[types.StateProjectPath] != ""),
+		)
+		if err := r.Start(); err != nil {
+			return err
+		}
+
+		if isNew {
+			if err := r.KubernetesClient.UpdateSecretWithState(restoredState(state)); err != nil {
+				return err
+			}
+		}
+	case !isRunning:
+		return xerrors.Errorf("the %s deployment is stopped, use the 'rasactl start' command to start it", r.Namespace)
+	}
+
+	if err := r.GetAllHelmValues(); err != nil {
+		return err
+	}
+
+	r.Spinner.Message("Restoring PostgreSQL database")
+	if err := r.restorePostgreSQL(files[types.BackupFilePostgreSQL]); err != nil {
+		return err
+	}
+
+	if file, ok := files[types.BackupFileModels]; ok && !r.Flags.Restore.SkipModels {
+		r.Spinner.Message("Restoring models")
+		if err := r.restoreModels(file); err != nil {
+			return err
+		}
+	}
+
+	r.Spinner.Message("Restarting Rasa X")
+	if err := r.KubernetesClient.DeleteRasaXPods(); err != nil {
+		return err
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("The %s deployment has been restored from %s\n", r.Namespace, r.Flags.Restore.File)
+	return nil
+}
+
+// restoredStateKeys defines keys of the backed up state that are written back to the state of a new deployment.
+// Other keys describe the helm release and Rasa X, they are stored once the deployment is started.
+var restoredStateKeys = []string{types.StateReplicas, types.StateConnectRasa, types.StateConnectActionServer}
+
+// restoredState returns the backed up state data that is written back to the state secret.
+func restoredState(state map[string]string) map[string][]byte {
+	data := map[string][]byte{}
+	for _, key := range restoredStateKeys {
+		if value, ok := state[key]; ok {
+			data[key] = []byte(value)
+		}
+	}
+
+	return data
+}
+
+// useBackupProjectPath starts a new deployment with the project directory recorded in the backup,
+// if the directory exists and kind is used. Otherwise the deployment is started without the project.
+func (r *RasaCtl) useBackupProjectPath(projectPath string) {
+	if projectPath == "" || r.Flags.Start.ProjectPath != "" || r.Flags.Start.Project {
+		return
+	}
+
+	if info, err := os.Stat(projectPath); err != nil || !info.IsDir() || r.DockerClient.GetKind().ControlPlaneHost == "" {
+		r.Log.Info("The project directory from the backup can't be used, the deployment is restored without it",
+			"projectPath", projectPath)
+		return
+	}
+
+	r.Log.Info("Using the project directory from the backup", "projectPath", projectPath)
+	r.Flags.Start.ProjectPath = projectPath
+}
+
+func readYAMLFile(file string, data interface{}) error {
+	d, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	return yaml.Unmarshal(d, data)
+}
+
+func (r *RasaCtl) restorePostgreSQL(file string) error {
+	pod, err := r.getPostgreSQLPodName()
+	if err != nil {
+		return err
+	}
+
+	username, password, err := r.KubernetesClient.GetPostgreSQLCreds()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	command := []string{
+		"env", fmt.Sprintf("PGPASSWORD=%s", password),
+		"psql", "--quiet", "-v", "ON_ERROR_STOP=1", "-h", "127.0.0.1",
+		"-U", username, "-d", r.getPostgreSQLDatabase(),
+	}
+
+	if err := r.exec(pod, "", command, f, ioutil.Discard); err != nil {
+		return xerrors.Errorf("can't restore the PostgreSQL database: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RasaCtl) restoreModels(file string) error {
+	pod, err := r.getRasaXPodName()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	command := []string{"tar", "xf", "-", "-C", filepath.Dir(rasaXModelsDir)}
+
+	if err := r.exec(pod, "rasa-x", command, f, ioutil.Discard); err != nil {
+		return xerrors.Errorf("can't restore models: %w", err)
+	}
+
+	return nil
+}
+
+// removeNamespaceSpecificValues removes values that rasactl generates for a given namespace,
+// so that they are generated again for the namespace that the backup is restored to.
+func removeNamespaceSpecificValues(values map[string]interface{}, namespace string, useProject bool) map[string]interface{} {
+	localHost := fmt.Sprintf("%s.%s", namespace, types.RasaCtlLocalDomain)
+
+	if ingress, ok := values["ingress"].(map[string]interface{}); ok {
+		if hosts, ok := ingress["hosts"].([]interface{}); ok && len(hosts) == 1 {
+			if host, ok := hosts[0].(map[string]interface{}); ok && host["host"] == localHost {
+				delete(ingress, "hosts")
+			}
+		}
+	}
+
+	// A local project directory is not a part of the backup,
+	// a restored deployment doesn't use a dedicated kind node.
+	if rasax, ok := values["rasax"].(map[string]interface{}); ok && useProject {
+		for _, key := range []string{"extraVolumes", "extraVolumeMounts", "tolerations", "nodeSelector"} {
+			delete(rasax, key)
+		}
+	}
+
+	return values
+}
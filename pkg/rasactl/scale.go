@@ -0,0 +1,46 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+)
+
+// Scale sets the replica count for a given component of a deployment.
+// If the deployment is stopped, the count is recorded and applied when the deployment is started.
+func (r *RasaCtl) Scale(component string, replicas int32) error {
+	// A deployment that is not fully running, e.g. with crash looping pods, is scaled as well,
+	// only the count for a stopped deployment is recorded without applying it.
+	isStopped, err := r.KubernetesClient.IsScaledDown()
+	if err != nil {
+		return err
+	}
+
+	r.Spinner.Message(fmt.Sprintf("Scaling the %s component", component))
+	if err := r.KubernetesClient.ScaleComponent(component, replicas, !isStopped); err != nil {
+		return err
+	}
+	r.Spinner.Stop()
+
+	if isStopped {
+		fmt.Printf("The %s deployment is stopped, the %s component will be scaled to %d replica(s) when the deployment is started.\n",
+			r.Namespace, component, replicas)
+		return nil
+	}
+
+	fmt.Printf("The %s component of the %s deployment has been scaled to %d replica(s).\n", component, r.Namespace, replicas)
+	return nil
+}
@@ -31,6 +31,12 @@ func (r *RasaCtl) Start() error {
 		return err
 	}
 
+	if !r.Flags.Start.SkipPreflight {
+		if err := r.preflight(); err != nil {
+			return err
+		}
+	}
+
 	if err := r.KubernetesClient.CreateNamespace(); err != nil {
 		return err
 	}
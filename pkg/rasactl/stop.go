@@ -27,12 +27,10 @@ func (r *RasaCtl) Stop() error {
 
 	r.initRasaXClient()
 
-	rasaXVersion, err := r.RasaXClient.GetVersionEndpoint()
-	if err != nil {
-		return err
-	}
-
-	if err := r.KubernetesClient.UpdateSecretWithState(rasaXVersion); err != nil {
+	// Rasa X of a degraded deployment may not respond, the version stored in the state is kept then.
+	if rasaXVersion, err := r.RasaXClient.GetVersionEndpoint(); err != nil {
+		r.Log.Info("Can't get the Rasa X version, the stored version is kept", "error", err)
+	} else if err := r.KubernetesClient.UpdateSecretWithState(rasaXVersion); err != nil {
 		return err
 	}
 
@@ -28,7 +28,8 @@ func (r *RasaCtl) Upgrade() error {
 	r.initRasaXClient()
 
 	r.Spinner.Message("Upgrading Rasa X")
-	if err := r.HelmClient.Upgrade(); err != nil {
+	if err := r.withProgress(r.HelmClient.Upgrade); err != nil {
+		r.diagnoseTimeout(err)
 		return err
 	}
 
@@ -38,7 +39,7 @@ func (r *RasaCtl) Upgrade() error {
 	}
 	r.RasaXClient.URL = url
 
-	if err := r.RasaXClient.WaitForRasaX(); err != nil {
+	if err := r.withProgress(r.RasaXClient.WaitForRasaX); err != nil {
 		return err
 	}
 
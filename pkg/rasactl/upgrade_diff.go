@@ -0,0 +1,261 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"sigs.k8s.io/yaml"
+
+	"github.com/RasaHQ/rasactl/pkg/status"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// UpgradeDryRun renders an upgrade of a deployment without applying it
+// and prints resources that would be changed. If the Diff flag is set,
+// a diff between the deployed and the upgraded manifests and values is printed.
+func (r *RasaCtl) UpgradeDryRun() error {
+	if err := utils.ValidateName(r.HelmClient.GetNamespace()); err != nil {
+		return err
+	}
+
+	r.Spinner.Message("Rendering the upgrade")
+	current, err := r.HelmClient.GetStatus()
+	if err != nil {
+		return err
+	}
+
+	upgraded, err := r.HelmClient.UpgradeDryRun()
+	if err != nil {
+		return err
+	}
+	r.Spinner.Stop()
+
+	currentResources, err := releaseResources(current)
+	if err != nil {
+		return err
+	}
+
+	upgradedResources, err := releaseResources(upgraded)
+	if err != nil {
+		return err
+	}
+
+	// Values are compared as they are, but the printed diff is redacted,
+	// so that passwords and tokens don't end up in CI logs.
+	valuesChanged, err := valuesDiff(current.Config, upgraded.Config)
+	if err != nil {
+		return err
+	}
+
+	valuesDiff, err := valuesDiff(utils.RedactValues(current.Config), utils.RedactValues(upgraded.Config))
+	if err != nil {
+		return err
+	}
+
+	changes := 0
+	fmt.Printf("Changes for the %s deployment (dry run, nothing has been applied):\n", r.Namespace)
+
+	if currentVersion, version := current.Chart.Metadata.Version, upgraded.Chart.Metadata.Version; currentVersion != version {
+		changes++
+		fmt.Printf("  helm chart version: %s -> %s\n", currentVersion, version)
+	}
+
+	if valuesChanged != "" {
+		changes++
+		fmt.Println("  ~ values")
+	}
+
+	diffs := map[string]string{}
+	for _, name := range resourceNames(currentResources, upgradedResources) {
+		from, isCurrent := currentResources[name]
+		to, isUpgraded := upgradedResources[name]
+
+		if from == to {
+			continue
+		}
+
+		from, to, err = redactSecretData(from, to)
+		if err != nil {
+			return err
+		}
+
+		diff, err := utils.UnifiedDiff(from, to, "deployed/"+name, "upgraded/"+name)
+		if err != nil {
+			return err
+		}
+		diffs[name] = diff
+		changes++
+
+		switch {
+		case !isCurrent:
+			fmt.Printf("  + %s\n", name)
+		case !isUpgraded:
+			fmt.Printf("  - %s\n", name)
+		default:
+			fmt.Printf("  ~ %s\n", name)
+		}
+	}
+
+	if changes == 0 {
+		fmt.Println("  no changes")
+		return nil
+	}
+
+	if !r.Flags.Upgrade.Diff {
+		return nil
+	}
+
+	if valuesDiff != "" {
+		fmt.Println()
+		status.PrintDiff(valuesDiff)
+	} else if valuesChanged != "" {
+		fmt.Println()
+		fmt.Println("Only sensitive values have changed, the values diff is redacted.")
+	}
+
+	for _, name := range resourceNames(diffs) {
+		fmt.Println()
+		status.PrintDiff(diffs[name])
+	}
+
+	return nil
+}
+
+// releaseResources returns manifests of a helm release, including hooks,
+// with a resource kind and name used as a key.
+func releaseResources(rel *release.Release) (map[string]string, error) {
+	resources := map[string]string{}
+	for _, manifest := range releaseutil.SplitManifests(releaseManifest(rel)) {
+		resource := struct {
+			Kind     string `json:"kind"`
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}{}
+
+		if err := yaml.Unmarshal([]byte(manifest), &resource); err != nil {
+			return nil, err
+		}
+
+		if resource.Kind == "" {
+			continue
+		}
+		resources[fmt.Sprintf("%s/%s", resource.Kind, resource.Metadata.Name)] = strings.TrimSpace(manifest) + "\n"
+	}
+
+	return resources, nil
+}
+
+// releaseManifest returns manifests of a helm release and its hooks as a single YAML stream.
+func releaseManifest(rel *release.Release) string {
+	manifests := []string{rel.Manifest}
+	for _, hook := range rel.Hooks {
+		manifests = append(manifests, hook.Manifest)
+	}
+
+	return strings.Join(manifests, "\n---\n")
+}
+
+// resourceNames returns sorted and unique keys of given maps.
+func resourceNames(resources ...map[string]string) []string {
+	names := []string{}
+	seen := map[string]bool{}
+
+	for _, r := range resources {
+		for name := range r {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func valuesDiff(current, upgraded map[string]interface{}) (string, error) {
+	from, err := yaml.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+
+	to, err := yaml.Marshal(upgraded)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.UnifiedDiff(string(from), string(to), "deployed/values", "upgraded/values")
+}
+
+// redactSecretData replaces values of the data and stringData fields in Secret manifests with a redacted value.
+// Values that differ between the manifests are marked as changed, so that the diff still shows which keys have changed.
+// Manifests of other kinds are returned as they are.
+func redactSecretData(from, to string) (string, string, error) {
+	fromSecret := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(from), &fromSecret); err != nil {
+		return "", "", err
+	}
+
+	toSecret := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(to), &toSecret); err != nil {
+		return "", "", err
+	}
+
+	if fromSecret["kind"] != "Secret" && toSecret["kind"] != "Secret" {
+		return from, to, nil
+	}
+
+	for _, field := range []string{"data", "stringData"} {
+		fromData, _ := fromSecret[field].(map[string]interface{})
+		toData, _ := toSecret[field].(map[string]interface{})
+
+		for key, value := range toData {
+			toData[key] = utils.RedactedValue
+			if fromValue, ok := fromData[key]; ok && fromValue != value {
+				toData[key] = fmt.Sprintf("%s (changed)", utils.RedactedValue)
+			}
+		}
+
+		for key := range fromData {
+			fromData[key] = utils.RedactedValue
+		}
+	}
+
+	from, err := marshalManifest(fromSecret)
+	if err != nil {
+		return "", "", err
+	}
+
+	to, err = marshalManifest(toSecret)
+	return from, to, err
+}
+
+// marshalManifest returns a YAML manifest of a given object, or an empty string if the object is empty,
+// e.g. if the resource doesn't exist in one of the compared releases.
+func marshalManifest(object map[string]interface{}) (string, error) {
+	if len(object) == 0 {
+		return "", nil
+	}
+
+	manifest, err := yaml.Marshal(object)
+	return string(manifest), err
+}
@@ -0,0 +1,230 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rasactl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+	"helm.sh/helm/v3/pkg/release"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	rtypes "github.com/RasaHQ/rasactl/pkg/types/rasax"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+// waitInterval defines how often a deployment is checked while waiting for a condition.
+const waitInterval = 5 * time.Second
+
+// waitStep waits for a single condition.
+type waitStep struct {
+	condition string
+	wait      func(ctx context.Context) error
+}
+
+// Wait blocks until a deployment reaches the condition passed by the --for flag.
+//
+// Errors that end the wait early are returned as types.ExitError, so that rasactl exits
+// with a distinct code if the condition is not reached before the timeout, the deployment
+// doesn't exist, or the deployment is unhealthy.
+func (r *RasaCtl) Wait() error {
+	condition := r.Flags.Wait.For
+	if !utils.StringSliceContains(types.WaitConditions, condition) {
+		return xerrors.Errorf("the %s condition is not supported, use one of: %s",
+			condition, strings.Join(types.WaitConditions, ", "))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Flags.Wait.Timeout)
+	defer cancel()
+
+	if err := r.withProgress(func() error { return r.waitForRunning(ctx) }); err != nil {
+		return r.waitError(ctx, condition, err)
+	}
+
+	if condition != types.WaitConditionRunning {
+		r.initRasaXClient()
+
+		// The token is required to list models, it's set before the progress view starts using the client.
+		if condition == types.WaitConditionModel {
+			token, err := r.getAuthToken()
+			if err != nil {
+				return err
+			}
+			r.RasaXClient.BearerToken = token
+		}
+
+		steps := []waitStep{
+			{condition: types.WaitConditionMigrated, wait: r.RasaXClient.WaitForDatabaseMigration},
+			{condition: types.WaitConditionProduction, wait: func(ctx context.Context) error {
+				return r.RasaXClient.WaitForRasaServer(ctx, "production")
+			}},
+			{condition: types.WaitConditionModel, wait: r.waitForProductionModel},
+		}
+
+		err := r.withProgress(func() error {
+			for _, step := range steps {
+				if err := step.wait(ctx); err != nil {
+					return err
+				}
+				if step.condition == condition {
+					return nil
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return r.waitError(ctx, condition, err)
+		}
+	}
+
+	r.Spinner.Stop()
+	fmt.Printf("The %s deployment has reached the %s condition\n", r.Namespace, condition)
+
+	return nil
+}
+
+// waitForRunning waits until all components of a deployment are running.
+func (r *RasaCtl) waitForRunning(ctx context.Context) error {
+	for {
+		isDeployed, isRunning, err := r.CheckDeploymentStatus()
+		if err != nil {
+			return err
+		}
+
+		if !isDeployed {
+			return &types.ExitError{
+				Code: types.ExitCodeNotFound,
+				Err:  xerrors.Errorf("the %s deployment doesn't have a Rasa X release", r.Namespace),
+			}
+		}
+
+		helmRelease, err := r.HelmClient.GetStatus()
+		if err != nil {
+			return err
+		}
+
+		if helmRelease.Info.Status == release.StatusFailed {
+			return &types.ExitError{
+				Code: types.ExitCodeUnhealthy,
+				Err:  xerrors.Errorf("the %s helm release has failed: %s", helmRelease.Name, helmRelease.Info.Description),
+			}
+		}
+
+		if isRunning {
+			return nil
+		}
+
+		r.Spinner.Message("Waiting for the deployment to be running")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitInterval):
+		}
+	}
+}
+
+// waitForProductionModel waits until a model tagged 'production' exists
+// and the production Rasa Server has loaded it.
+func (r *RasaCtl) waitForProductionModel(ctx context.Context) error {
+	for {
+		models, err := r.RasaXClient.ModelList()
+		if err != nil {
+			return err
+		}
+
+		message := "Waiting for a model tagged 'production'"
+		for _, model := range models.Models {
+			if !utils.StringSliceContains(model.Tags, "production") {
+				continue
+			}
+
+			loaded, err := r.isModelLoadedInProduction(model)
+			if err != nil {
+				r.Log.V(1).Info("Can't get the production Rasa Server status", "error", err)
+			}
+
+			if loaded {
+				r.Log.Info("The model tagged 'production' is loaded", "model", model.Model)
+				return nil
+			}
+
+			message = fmt.Sprintf("Waiting for the production Rasa Server to load the %s model", model.Model)
+			break
+		}
+
+		r.Spinner.Message(message)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitInterval):
+		}
+	}
+}
+
+// isModelLoadedInProduction checks if a given model is loaded by the production Rasa Server.
+// The model is recognized by the model file name, or by the training time stored in the model fingerprint,
+// a model pulled from Rasa X is stored in a temporary directory that doesn't include the model name.
+func (r *RasaCtl) isModelLoadedInProduction(model rtypes.ModelSpec) (bool, error) {
+	status, err := r.RasaXClient.GetProductionStatus()
+	if err != nil {
+		return false, err
+	}
+
+	r.Log.V(1).Info("Production Rasa Server status", "modelFile", status.ModelFile, "fingerprint", status.Fingerprint)
+
+	if status.ModelFile == "" {
+		return false, nil
+	}
+
+	if strings.TrimSuffix(filepath.Base(status.ModelFile), ".tar.gz") == model.Model {
+		return true, nil
+	}
+
+	trainedAt, ok := status.Fingerprint["trained_at"].(float64)
+	return ok && math.Abs(trainedAt-model.TrainedAt) < 1, nil
+}
+
+// waitError returns an error with an exit code for a failed wait. If the timeout has been reached
+// and pods or volumes of the deployment have problems, the deployment is reported as unhealthy.
+func (r *RasaCtl) waitError(ctx context.Context, condition string, err error) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+
+	causes, diagnoseErr := r.diagnoseRelease()
+	if diagnoseErr != nil {
+		r.Log.Info("Can't diagnose the deployment", "error", diagnoseErr)
+	}
+
+	if len(causes) != 0 {
+		return &types.ExitError{
+			Code: types.ExitCodeUnhealthy,
+			Err: xerrors.Errorf("timed out waiting for the %s condition, the deployment is unhealthy:\n%s",
+				condition, strings.Join(causes, "\n")),
+		}
+	}
+
+	return &types.ExitError{
+		Code: types.ExitCodeTimeout,
+		Err:  xerrors.Errorf("timed out waiting for the %s condition", condition),
+	}
+}
@@ -110,6 +110,40 @@ func (r *RasaX) GetHealthEndpoint() (*rtypes.HealthEndpointsResponse, error) {
 	return nil, xerrors.Errorf("The Rasa X health endpoint has returned status code %s", resp.Status)
 }
 
+// GetProductionStatus returns the status of Rasa Server in the production environment.
+// Rasa X routes requests for the /core path to the production Rasa Server.
+func (r *RasaX) GetProductionStatus() (*rtypes.RasaServerStatusResponse, error) {
+	url := fmt.Sprintf("%s/core/status", r.getURL())
+	r.Log.V(1).Info("Sending a request to Rasa Server", "url", url)
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", r.BearerToken))
+	resp, err := r.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, xerrors.Errorf("the production Rasa Server status endpoint has returned status code %s: %s",
+			resp.Status, body)
+	}
+
+	bodyData := &rtypes.RasaServerStatusResponse{}
+	if err := json.Unmarshal(body, bodyData); err != nil {
+		return nil, err
+	}
+	return bodyData, nil
+}
+
 func (r *RasaX) GetVersionEndpoint() (*rtypes.VersionEndpointResponse, error) {
 	urlAddress := r.getURL()
 
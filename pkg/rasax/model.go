@@ -25,49 +25,78 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/schollz/progressbar/v3"
 	"golang.org/x/xerrors"
 
 	rtypes "github.com/RasaHQ/rasactl/pkg/types/rasax"
+	"github.com/RasaHQ/rasactl/pkg/utils"
 )
 
+// modelDownloadAttempts defines how many times an interrupted model download is resumed.
+const modelDownloadAttempts int = 5
+
+// ModelUpload uploads a model file to Rasa X.
+//
+// The file is streamed from disk, and once the upload is finished,
+// the checksum of the file is compared with the model hash reported by Rasa X.
 func (r *RasaX) ModelUpload() error {
 	file, err := os.Open(r.Flags.Model.Upload.File)
-	stat, _ := file.Stat()
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	bar := r.progressBarBytes(
-		stat.Size(),
-		fmt.Sprintf("Sending %s", filepath.Base(file.Name())),
-	)
-
-	buffer := new(bytes.Buffer)
-	body := progressbar.NewReader(buffer, bar)
-	writer := multipart.NewWriter(buffer)
-	part, err := writer.CreateFormFile("model", filepath.Base(file.Name()))
+	stat, err := file.Stat()
 	if err != nil {
 		return err
 	}
 
-	if _, err := io.Copy(part, file); err != nil {
+	fileName := filepath.Base(file.Name())
+	reader, writer := io.Pipe()
+	multipartWriter := multipart.NewWriter(writer)
+
+	contentLength, err := multipartContentLength(multipartWriter.Boundary(), "model", fileName, stat.Size())
+	if err != nil {
 		return err
 	}
 
-	writer.Close()
-	//buffer
+	// The multipart body is written in a separate goroutine, the request reads it
+	// from the pipe while sending, so the file is never loaded into memory.
+	checksum := newFileChecksum()
+	go func() {
+		part, err := multipartWriter.CreateFormFile("model", fileName)
+		if err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+
+		if _, err := io.Copy(part, io.TeeReader(file, checksum)); err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+
+		writer.CloseWithError(multipartWriter.Close())
+	}()
+
+	bar := r.progressBarBytes(
+		contentLength,
+		fmt.Sprintf("Sending %s", fileName),
+	)
+	body := progressbar.NewReader(reader, bar)
+
 	urlAddress := r.getURL()
 	url := fmt.Sprintf("%s/api/projects/default/models", urlAddress)
-	r.Log.V(1).Info("Sending a request to Rasa X", "url", url)
+	r.Log.V(1).Info("Sending a request to Rasa X", "url", url, "contentLength", contentLength)
 	request, err := http.NewRequest("POST", url, &body)
 	if err != nil {
 		return err
 	}
+	request.ContentLength = contentLength
 
-	request.Header.Add("Content-Type", writer.FormDataContentType())
+	request.Header.Add("Content-Type", multipartWriter.FormDataContentType())
 	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", r.BearerToken))
 	client := &http.Client{}
 
@@ -77,9 +106,21 @@ func (r *RasaX) ModelUpload() error {
 	}
 	defer response.Body.Close()
 
+	modelName := strings.TrimSuffix(fileName, ".tar.gz")
+
 	switch response.StatusCode {
 	case 201:
 		fmt.Println("Successfully uploaded.")
+
+		model, err := r.findModel(modelName)
+		if err != nil {
+			return xerrors.Errorf("can't verify the uploaded model: %w", err)
+		}
+
+		if !checksum.matches(model.Hash) {
+			return xerrors.Errorf("the checksum of the uploaded model doesn't match the local file, model hash: %s", model.Hash)
+		}
+		fmt.Printf("Checksum verified, model hash: %s\n", model.Hash)
 	case 401:
 		return xerrors.Errorf("unauthorized, use the 'rasactl auth login' command to authorized")
 	case 409:
@@ -92,25 +133,27 @@ func (r *RasaX) ModelUpload() error {
 	return nil
 }
 
-func (r *RasaX) ModelDownload() error {
-	urlAddress := r.getURL()
-	url := fmt.Sprintf("%s/api/projects/default/models/%s", urlAddress, r.Flags.Model.Download.Name)
-	r.Log.V(1).Info("Sending a request to Rasa X", "url", url)
-	request, err := http.NewRequest("GET",
-		url, nil)
-	if err != nil {
-		return err
+// multipartContentLength returns the size of a multipart body with a single file field.
+func multipartContentLength(boundary, field, fileName string, fileSize int64) (int64, error) {
+	buffer := new(bytes.Buffer)
+	writer := multipart.NewWriter(buffer)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return 0, err
 	}
 
-	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", r.BearerToken))
-	client := &http.Client{}
+	if _, err := writer.CreateFormFile(field, fileName); err != nil {
+		return 0, err
+	}
 
-	resp, err := client.Do(request)
-	if err != nil {
-		return err
+	if err := writer.Close(); err != nil {
+		return 0, err
 	}
-	defer resp.Body.Close()
 
+	return int64(buffer.Len()) + fileSize, nil
+}
+
+// ModelDownload downloads a model from Rasa X.
+func (r *RasaX) ModelDownload() error {
 	var file string = r.Flags.Model.Download.FilePath
 	if r.Flags.Model.Download.FilePath == "" {
 		dir, err := os.Getwd()
@@ -119,33 +162,198 @@ func (r *RasaX) ModelDownload() error {
 		}
 		file = fmt.Sprintf("%s/%s.tar.gz", dir, r.Flags.Model.Download.Name)
 	}
-	r.Log.Info("Starting to download the model",
-		"storePath", file, "model", r.Flags.Model.Download.Name)
 
-	switch resp.StatusCode {
-	case 200:
-		f, _ := os.OpenFile(file, os.O_CREATE|os.O_WRONLY, 0644)
-		defer f.Close()
+	model, err := r.findModel(r.Flags.Model.Download.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := r.downloadModel(model, file); err != nil {
+		return err
+	}
+
+	fmt.Println("Model has been downloaded successfully.")
+	return nil
+}
+
+// ModelDownloadAll downloads all models, or models with the tag defined by the --tag flag, to a directory.
+func (r *RasaX) ModelDownloadAll() error {
+	var dir string = r.Flags.Model.Download.FilePath
+	if dir == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		dir = cwd
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	models, err := r.ModelList()
+	if err != nil {
+		return err
+	}
+
+	downloaded := 0
+	for _, model := range models.Models {
+		if tag := r.Flags.Model.Download.Tag; tag != "" && !utils.StringSliceContains(model.Tags, tag) {
+			continue
+		}
 
-		bar := r.progressBarBytes(
-			resp.ContentLength,
-			fmt.Sprintf("Downloading %s", r.Flags.Model.Download.Name),
-		)
+		model := model
+		if err := r.downloadModel(&model, filepath.Join(dir, fmt.Sprintf("%s.tar.gz", model.Model))); err != nil {
+			return err
+		}
+		downloaded++
+	}
+
+	if downloaded == 0 {
+		fmt.Println("Nothing to download, there are no models that match the criteria.")
+		return nil
+	}
+
+	fmt.Printf("%d model(s) have been downloaded to %s.\n", downloaded, dir)
+	return nil
+}
 
-		if _, err := io.Copy(io.MultiWriter(f, bar), resp.Body); err != nil {
+// downloadModel downloads a model to a given file and verifies the file against the model hash.
+//
+// The model is downloaded to a temporary '.part' file first, if the download is interrupted,
+// it's resumed from the point where it stopped, also if the command is run again.
+func (r *RasaX) downloadModel(model *rtypes.ModelSpec, file string) error {
+	if matches, err := fileMatchesHash(file, model.Hash); err != nil {
+		return err
+	} else if matches {
+		r.Log.Info("The model is already downloaded", "model", model.Model, "storePath", file)
+		return nil
+	}
+
+	partFile := fmt.Sprintf("%s.part", file)
+	r.Log.Info("Starting to download the model",
+		"storePath", file, "model", model.Model)
+
+	var err error
+	for attempt := 1; attempt <= modelDownloadAttempts; attempt++ {
+		var interrupted bool
+		interrupted, err = r.downloadModelPart(model.Model, partFile)
+		if err == nil || !interrupted {
+			break
+		}
+
+		r.Log.Info("The download has been interrupted, resuming",
+			"model", model.Model, "attempt", attempt, "error", err.Error())
+		time.Sleep(time.Second * 2)
+	}
+	if err != nil {
+		return err
+	}
+
+	if model.Hash == "" {
+		r.Log.Info("Rasa X doesn't report the model hash, skipping verification", "model", model.Model)
+	} else {
+		matches, err := fileMatchesHash(partFile, model.Hash)
+		if err != nil {
 			return err
 		}
 
-		fmt.Println("Model has been downloaded successfully.")
+		if !matches {
+			if err := os.Remove(partFile); err != nil {
+				return err
+			}
+			return xerrors.Errorf(
+				"the checksum of the downloaded '%s' model doesn't match the model hash %s, the downloaded file has been removed",
+				model.Model, model.Hash,
+			)
+		}
+	}
+
+	return os.Rename(partFile, file)
+}
+
+// downloadModelPart downloads a model to a given file. If the file is not empty,
+// only the missing part of the model is requested.
+//
+// It returns 'true' if the download has been interrupted and can be resumed.
+func (r *RasaX) downloadModelPart(name, file string) (bool, error) {
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return false, err
+	}
+
+	urlAddress := r.getURL()
+	url := fmt.Sprintf("%s/api/projects/default/models/%s", urlAddress, name)
+	r.Log.V(1).Info("Sending a request to Rasa X", "url", url, "offset", offset)
+	request, err := http.NewRequest("GET",
+		url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", r.BearerToken))
+	if offset > 0 {
+		request.Header.Add("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	client := &http.Client{}
+
+	resp, err := client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 206:
+		r.Log.Info("Resuming the download", "model", name, "offset", offset)
+	case 200:
+		// The server doesn't support range requests, start from the beginning.
+		if offset > 0 {
+			if err := f.Truncate(0); err != nil {
+				return false, err
+			}
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				return false, err
+			}
+			offset = 0
+		}
+	case 416:
+		// The file is already complete.
+		return false, nil
 	case 404:
-		return xerrors.Errorf("model '%s' not found", r.Flags.Model.Download.Name)
+		return false, xerrors.Errorf("model '%s' not found", name)
 	case 401:
-		return xerrors.Errorf("unauthorized, use the 'rasactl auth login' command to authorized")
+		return false, xerrors.Errorf("unauthorized, use the 'rasactl auth login' command to authorized")
 	default:
 		content, _ := ioutil.ReadAll(resp.Body)
-		return xerrors.Errorf("%s", content)
+		return false, xerrors.Errorf("%s", content)
 	}
-	return nil
+
+	size := int64(-1)
+	if resp.ContentLength >= 0 {
+		size = offset + resp.ContentLength
+	}
+
+	bar := r.progressBarBytes(
+		size,
+		fmt.Sprintf("Downloading %s", name),
+	)
+	if offset > 0 && bar != nil {
+		//nolint:golint,errcheck
+		bar.Set64(offset)
+	}
+
+	if _, err := io.Copy(io.MultiWriter(f, bar), resp.Body); err != nil {
+		return true, err
+	}
+
+	return false, nil
 }
 
 func (r *RasaX) ModelList() (*rtypes.ModelsListEndpointResponse, error) {
@@ -218,8 +426,18 @@ func (r *RasaX) ModelTag() error {
 }
 
 func (r *RasaX) ModelDelete() error {
+	if err := r.DeleteModel(r.Flags.Model.Delete.Name); err != nil {
+		return err
+	}
+
+	fmt.Println("Model has been deleted successfully.")
+	return nil
+}
+
+// DeleteModel deletes a model with a given name.
+func (r *RasaX) DeleteModel(name string) error {
 	urlAddress := r.getURL()
-	url := fmt.Sprintf("%s/api/projects/default/models/%s", urlAddress, r.Flags.Model.Delete.Name)
+	url := fmt.Sprintf("%s/api/projects/default/models/%s", urlAddress, name)
 	r.Log.V(1).Info("Sending a request to Rasa X", "url", url)
 	request, err := http.NewRequest("DELETE", url, nil)
 	if err != nil {
@@ -237,12 +455,11 @@ func (r *RasaX) ModelDelete() error {
 
 	switch resp.StatusCode {
 	case 204:
-		fmt.Println("Model has been deleted successfully.")
 		return nil
 	case 401:
 		return xerrors.Errorf("unauthorized, use the 'rasactl auth login' command to authorized")
 	case 404:
-		return xerrors.Errorf("model '%s' not found", r.Flags.Model.Delete.Name)
+		return xerrors.Errorf("model '%s' not found", name)
 	default:
 		content, _ := ioutil.ReadAll(resp.Body)
 		return xerrors.Errorf("%s", content)
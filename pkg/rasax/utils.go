@@ -1,11 +1,20 @@
 package rasax
 
 import (
+	"crypto/md5" //nolint:golint,gosec
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/schollz/progressbar/v3"
+	"golang.org/x/xerrors"
+
+	rtypes "github.com/RasaHQ/rasactl/pkg/types/rasax"
 )
 
 func (r *RasaX) progressBarBytes(maxBytes int64, description ...string) *progressbar.ProgressBar {
@@ -34,3 +43,61 @@ func (r *RasaX) progressBarBytes(maxBytes int64, description ...string) *progres
 	}
 	return bar
 }
+
+// fileChecksum calculates MD5 and SHA256 checksums of data written to it,
+// so that data can be compared with a model hash independently of the hash algorithm.
+type fileChecksum struct {
+	md5    hash.Hash
+	sha256 hash.Hash
+}
+
+func newFileChecksum() *fileChecksum {
+	return &fileChecksum{md5: md5.New(), sha256: sha256.New()}
+}
+
+func (c *fileChecksum) Write(p []byte) (int, error) {
+	c.md5.Write(p)    //nolint:golint,errcheck
+	c.sha256.Write(p) //nolint:golint,errcheck
+	return len(p), nil
+}
+
+// matches returns 'true' if a given hex encoded hash is equal to one of the checksums.
+func (c *fileChecksum) matches(hash string) bool {
+	hash = strings.ToLower(hash)
+	return hash == hex.EncodeToString(c.md5.Sum(nil)) || hash == hex.EncodeToString(c.sha256.Sum(nil))
+}
+
+// findModel returns a model with a given name.
+func (r *RasaX) findModel(name string) (*rtypes.ModelSpec, error) {
+	models, err := r.ModelList()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, model := range models.Models {
+		if model.Model == name {
+			return &model, nil
+		}
+	}
+
+	return nil, xerrors.Errorf("model '%s' not found", name)
+}
+
+// fileMatchesHash checks if the checksum of a given file matches the hash.
+// It returns 'false' if the file doesn't exist.
+func fileMatchesHash(file, hash string) (bool, error) {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	checksum := newFileChecksum()
+	if _, err := io.Copy(checksum, f); err != nil {
+		return false, err
+	}
+
+	return checksum.matches(hash), nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package status
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+// PrintCheckResults prints results of diagnostic checks, each result is followed
+// by a remediation hint if the check hasn't passed.
+func PrintCheckResults(results []types.CheckResult) {
+	width := 0
+	for _, result := range results {
+		if len(result.Name) > width {
+			width = len(result.Name)
+		}
+	}
+
+	for _, result := range results {
+		label := color.New(color.FgGreen).Sprint("[pass]")
+		switch result.Status {
+		case types.CheckStatusWarn:
+			label = color.New(color.FgYellow).Sprint("[warn]")
+		case types.CheckStatusFail:
+			label = color.New(color.FgRed).Sprint("[fail]")
+		}
+
+		fmt.Fprintf(color.Output, "%s %-*s  %s\n", label, width, result.Name, result.Message)
+		if result.Hint != "" && result.Status != types.CheckStatusPass {
+			fmt.Fprintf(color.Output, "       %-*s  hint: %s\n", width, "", result.Hint)
+		}
+	}
+}
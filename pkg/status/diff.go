@@ -0,0 +1,41 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package status
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// PrintDiff prints a unified diff, added lines are printed in green and removed lines in red.
+func PrintDiff(diff string) {
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			color.New(color.Bold).Print(line)
+		case strings.HasPrefix(line, "@@"):
+			color.Cyan(strings.TrimSuffix(line, "\n"))
+		case strings.HasPrefix(line, "+"):
+			color.Green(strings.TrimSuffix(line, "\n"))
+		case strings.HasPrefix(line, "-"):
+			color.Red(strings.TrimSuffix(line, "\n"))
+		default:
+			fmt.Print(line)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package status
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	rtypes "github.com/RasaHQ/rasactl/pkg/types/rasax"
+)
+
+// ProgressLines returns lines of a view with the startup progress of a deployment.
+// The view lists ready and desired replicas for each workload, and if health is not nil,
+// the database migration progress and health of the production and worker environments.
+func ProgressLines(workloads []types.WorkloadProgress, health *rtypes.HealthEndpointsResponse) []string {
+	width := 0
+	for _, workload := range workloads {
+		if len(workload.Name) > width {
+			width = len(workload.Name)
+		}
+	}
+	if health != nil && width < len("database migration") {
+		width = len("database migration")
+	}
+
+	lines := []string{}
+	for _, workload := range workloads {
+		lines = append(lines, fmt.Sprintf("  %s %-*s  %d/%d",
+			progressMark(workload.IsReady()), width, workload.Name, workload.Ready, workload.Desired))
+	}
+
+	if health == nil {
+		return lines
+	}
+
+	migration := health.DatabaseMigration
+	lines = append(lines, fmt.Sprintf("  %s %-*s  %.2f%%",
+		progressMark(migration.Status == "completed"), width, "database migration", migration.ProgressInPercent))
+
+	for _, environment := range []struct {
+		name string
+		spec rtypes.EnvironmentSpec
+	}{
+		{name: "production", spec: health.Production},
+		{name: "worker", spec: health.Worker},
+	} {
+		state := "ready"
+		if environment.spec.Status != 200 {
+			state = fmt.Sprintf("status: %d", environment.spec.Status)
+		}
+		lines = append(lines, fmt.Sprintf("  %s %-*s  %s",
+			progressMark(environment.spec.Status == 200), width, environment.name, state))
+	}
+
+	return lines
+}
+
+// progressMark returns a mark that indicates if an item is ready.
+func progressMark(ready bool) string {
+	if ready {
+		return color.New(color.FgGreen).Sprint("✓")
+	}
+	return color.New(color.FgYellow).Sprint("•")
+}
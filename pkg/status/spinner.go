@@ -18,6 +18,7 @@ package status
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -28,12 +29,19 @@ import (
 // SpinnerMessage defines a spinner object.
 type SpinnerMessage struct {
 	spinner *spinner.Spinner
+
+	// details are lines printed above the spinner line,
+	// printedDetails is the number of lines that are currently printed.
+	details        []string
+	detailsChanged bool
+	printedDetails int
 }
 
 // NewSpinner creates a new spinner object.
 func NewSpinner() *SpinnerMessage {
 	s := &SpinnerMessage{}
 	s.spinner = spinner.New(spinner.CharSets[69], 200*time.Millisecond, spinner.WithWriter(os.Stderr))
+	s.spinner.PreUpdate = s.printDetails
 	return s
 }
 
@@ -56,3 +64,54 @@ func (s *SpinnerMessage) Stop() {
 		s.spinner.Stop()
 	}
 }
+
+// Details sets lines that are printed above the spinner line, e.g. a progress view.
+// The lines are redrawn while the spinner is active.
+func (s *SpinnerMessage) Details(lines []string) {
+	if utils.IsDebugOrVerboseEnabled() {
+		return
+	}
+
+	s.spinner.Lock()
+	defer s.spinner.Unlock()
+
+	if strings.Join(lines, "\n") != strings.Join(s.details, "\n") {
+		s.details = lines
+		s.detailsChanged = true
+	}
+}
+
+// ClearDetails removes lines printed above the spinner line.
+func (s *SpinnerMessage) ClearDetails() {
+	s.spinner.Lock()
+	defer s.spinner.Unlock()
+
+	s.eraseDetails()
+	s.details = nil
+	s.detailsChanged = false
+}
+
+// printDetails redraws the details if they have changed. It's executed by the spinner
+// before the spinner line is printed, the cursor is at the beginning of the spinner line.
+func (s *SpinnerMessage) printDetails(sp *spinner.Spinner) {
+	if !s.detailsChanged {
+		return
+	}
+
+	s.eraseDetails()
+	for _, line := range s.details {
+		fmt.Fprintf(sp.Writer, "%s\n", line)
+	}
+	s.printedDetails = len(s.details)
+	s.detailsChanged = false
+}
+
+// eraseDetails moves the cursor to the first line of the details and clears everything below.
+func (s *SpinnerMessage) eraseDetails() {
+	if s.printedDetails == 0 {
+		return
+	}
+
+	fmt.Fprintf(s.spinner.Writer, "\033[%dA\r\033[J", s.printedDetails)
+	s.printedDetails = 0
+}
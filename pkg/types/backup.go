@@ -0,0 +1,47 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package types
+
+import "time"
+
+const (
+	// BackupFileMetadata is a name of the file that stores backup metadata.
+	BackupFileMetadata string = "metadata.yaml"
+
+	// BackupFileState is a name of the file that stores data from the rasactl state secret.
+	BackupFileState string = "state.yaml"
+
+	// BackupFileValues is a name of the file that stores helm values.
+	BackupFileValues string = "values.yaml"
+
+	// BackupFilePostgreSQL is a name of the file that stores a PostgreSQL dump.
+	BackupFilePostgreSQL string = "postgresql.sql"
+
+	// BackupFileModels is a name of the file that stores a tar archive with models.
+	BackupFileModels string = "models.tar"
+)
+
+// BackupMetadata stores information about a backup.
+type BackupMetadata struct {
+	// Namespace is a name of the deployment the backup was created for.
+	Namespace string `json:"namespace"`
+
+	// CreatedAt is time when the backup was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// RasactlVersion is a rasactl version used to create the backup.
+	RasactlVersion string `json:"rasactlVersion"`
+}
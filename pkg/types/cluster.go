@@ -0,0 +1,37 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package types
+
+import (
+	"github.com/RasaHQ/rasactl/misc/coredns"
+)
+
+const (
+	// KindClusterName is the default name of a kind cluster managed by rasactl.
+	KindClusterName string = "kind"
+
+	// IngressNginxKindManifestURL is the URL to the ingress-nginx manifest prepared for kind.
+	// The manifest is pinned to a released version of the controller.
+	IngressNginxKindManifestURL string = "https://raw.githubusercontent.com/kubernetes/ingress-nginx/" +
+		"controller-v1.1.1/deploy/static/provider/kind/deploy.yaml"
+
+	// IngressNginxAdmissionWebhook is the name of the ingress-nginx validating webhook configuration.
+	IngressNginxAdmissionWebhook string = "ingress-nginx-admission"
+)
+
+// CoreDNSZoneFile is the zone file for the rasactl.localhost domain,
+// the same zone is used by the rasa/rasactl:coredns image (see misc/coredns).
+var CoreDNSZoneFile = coredns.ZoneFile
@@ -0,0 +1,80 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package types
+
+import "time"
+
+const (
+	// DebugBundleFileMetadata is a name of the file that stores debug bundle metadata.
+	DebugBundleFileMetadata string = "metadata.yaml"
+
+	// DebugBundleFileRelease is a name of the file that stores the helm release summary.
+	DebugBundleFileRelease string = "helm-release.yaml"
+
+	// DebugBundleFileValues is a name of the file that stores redacted helm values.
+	DebugBundleFileValues string = "helm-values.yaml"
+
+	// DebugBundleFileStateKeys is a name of the file that stores keys of the rasactl state secret.
+	DebugBundleFileStateKeys string = "state-keys.yaml"
+
+	// DebugBundleFilePods is a name of the file that stores the pod list with pod statuses.
+	DebugBundleFilePods string = "pods.yaml"
+
+	// DebugBundleFileEvents is a name of the file that stores namespace events.
+	DebugBundleFileEvents string = "events.yaml"
+
+	// DebugBundleFileNodes is a name of the file that stores information about cluster nodes.
+	DebugBundleFileNodes string = "nodes.yaml"
+
+	// DebugBundleFileRasaXHealth is a name of the file that stores the /api/health response.
+	DebugBundleFileRasaXHealth string = "rasa-x-health.json"
+
+	// DebugBundleFileRasaXVersion is a name of the file that stores the /api/version response.
+	DebugBundleFileRasaXVersion string = "rasa-x-version.json"
+
+	// DebugBundleFileDocker is a name of the file that stores information about Docker and kind.
+	DebugBundleFileDocker string = "docker.yaml"
+
+	// DebugBundleFileErrors is a name of the file that stores errors that occurred during collection.
+	DebugBundleFileErrors string = "errors.txt"
+
+	// DebugBundleLogsDir is a directory within the bundle that stores container logs.
+	DebugBundleLogsDir string = "logs"
+)
+
+// DebugBundleMetadata stores information about a debug bundle.
+type DebugBundleMetadata struct {
+	// Namespace is a name of the deployment the bundle was created for.
+	Namespace string `json:"namespace"`
+
+	// CreatedAt is time when the bundle was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// RasactlVersion is a rasactl version used to create the bundle.
+	RasactlVersion string `json:"rasactlVersion"`
+
+	// OS is an operating system rasactl runs on.
+	OS string `json:"os"`
+
+	// Arch is an architecture rasactl runs on.
+	Arch string `json:"arch"`
+
+	// KubernetesBackend is a Kubernetes cluster type.
+	KubernetesBackend KubernetesBackendType `json:"kubernetesBackend"`
+
+	// CloudProvider is a detected cloud provider.
+	CloudProvider CloudProvider `json:"cloudProvider"`
+}
@@ -0,0 +1,45 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package types
+
+// CheckStatus is a result status of a diagnostic check.
+type CheckStatus string
+
+const (
+	// CheckStatusPass indicates that no problem has been found.
+	CheckStatusPass CheckStatus = "pass"
+
+	// CheckStatusWarn indicates a problem that might affect a deployment.
+	CheckStatusWarn CheckStatus = "warn"
+
+	// CheckStatusFail indicates a problem that prevents a deployment from working.
+	CheckStatusFail CheckStatus = "fail"
+)
+
+// CheckResult stores a result of a diagnostic check.
+type CheckResult struct {
+	// Name is a short name of the check.
+	Name string `json:"name"`
+
+	// Status is a result status of the check.
+	Status CheckStatus `json:"status"`
+
+	// Message describes the result of the check.
+	Message string `json:"message"`
+
+	// Hint describes how to fix a problem found by the check.
+	Hint string `json:"hint,omitempty"`
+}
@@ -23,6 +23,9 @@ const (
 
 	// HelmChartVersionRasaX storage a version of helm chart used to deploy Rasa X / Enterprise.
 	HelmChartVersionRasaX string = "4.3.3"
+
+	// HelmChartRepositoryRasaX stores a URL of the default helm chart repository for the rasa-x helm chart.
+	HelmChartRepositoryRasaX string = "https://rasahq.github.io/rasa-x-helm"
 )
 
 // RepositorySpec stores data related to a helm repository.
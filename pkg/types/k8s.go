@@ -26,3 +26,16 @@ const (
 	// remotely. The remote type means that external IP address is used to connect to the Kubernetes API.
 	KubernetesBackendRemote KubernetesBackendType = "remote"
 )
+
+// WorkloadProgress stores the rollout progress of a deployment or a statefulset.
+type WorkloadProgress struct {
+	Kind    string
+	Name    string
+	Ready   int32
+	Desired int32
+}
+
+// IsReady returns 'true' if all desired replicas of a workload are ready.
+func (w WorkloadProgress) IsReady() bool {
+	return w.Ready >= w.Desired
+}
@@ -0,0 +1,85 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package types
+
+const (
+	// ManifestAPIVersion is a version of the deployment manifest supported by rasactl.
+	ManifestAPIVersion string = "rasactl.rasa.com/v1alpha1"
+
+	// ManifestKindDeployment is a kind of the deployment manifest.
+	ManifestKindDeployment string = "Deployment"
+)
+
+// DeploymentManifest defines a declarative specification of a deployment
+// that is used by the 'rasactl apply' command.
+type DeploymentManifest struct {
+	APIVersion string                 `json:"apiVersion"`
+	Kind       string                 `json:"kind"`
+	Metadata   ManifestMetadata       `json:"metadata"`
+	Spec       DeploymentManifestSpec `json:"spec"`
+}
+
+// ManifestMetadata stores metadata of a manifest.
+type ManifestMetadata struct {
+	// Name is a deployment name.
+	Name string `json:"name"`
+}
+
+// DeploymentManifestSpec stores a desired state of a deployment.
+type DeploymentManifestSpec struct {
+	// ReleaseName is a helm release name, "rasa-x" is used if empty.
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// ChartVersion is a version of the rasa-x helm chart.
+	ChartVersion string `json:"chartVersion,omitempty"`
+
+	// ValuesFiles is a list of values files, the files are merged in the order they are defined.
+	ValuesFiles []string `json:"valuesFiles,omitempty"`
+
+	// ProjectPath is a path to a local Rasa project mounted in kind.
+	ProjectPath string `json:"projectPath,omitempty"`
+
+	// Enterprise stores a configuration for Rasa Enterprise.
+	Enterprise ManifestEnterpriseSpec `json:"enterprise,omitempty"`
+
+	// RasaX stores a configuration for Rasa X.
+	RasaX ManifestRasaXSpec `json:"rasaX,omitempty"`
+}
+
+// ManifestEnterpriseSpec stores a configuration for Rasa Enterprise.
+type ManifestEnterpriseSpec struct {
+	// License is a source of an Enterprise license.
+	License *ValueSource `json:"license,omitempty"`
+}
+
+// ManifestRasaXSpec stores a configuration for Rasa X.
+type ManifestRasaXSpec struct {
+	// Password is a source of the Rasa X password.
+	Password *ValueSource `json:"password,omitempty"`
+}
+
+// ValueSource defines where a value is read from.
+// Only one of the fields can be set.
+type ValueSource struct {
+	// Value is a value passed directly.
+	Value string `json:"value,omitempty"`
+
+	// Env is a name of an environment variable that stores the value.
+	Env string `json:"env,omitempty"`
+
+	// File is a path to a file that stores the value.
+	File string `json:"file,omitempty"`
+}
@@ -15,6 +15,9 @@ limitations under the License.
 */
 package rasa
 
+// ImageName is the name of the Rasa OSS image.
+const ImageName string = "rasa/rasa"
+
 // CredentialsFile defines the credential.yaml file used by Rasa OSS.
 type CredentialsFile struct {
 	Rasa struct {
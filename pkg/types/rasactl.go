@@ -15,31 +15,64 @@ limitations under the License.
 */
 package types
 
+import "time"
+
 const (
 	RasaCtlLocalDomain     string = "rasactl.localhost"
 	RasaCtlAuthUserEnv     string = "RASACTL_AUTH_USER"
 	RasaCtlAuthPasswordEnv string = "RASACTL_AUTH_PASSWORD" //nolint:golint,gosec
+	RasaXDefaultPassword   string = "rasaxlocal"            //nolint:golint,gosec
+)
+
+// Addresses under which Rasa OSS reaches services of a deployment.
+const (
+	EndpointsAddressNodePort    string = "nodeport"
+	EndpointsAddressPortForward string = "port-forward"
+	EndpointsAddressClusterDNS  string = "cluster-dns"
 )
 
 type RasaCtlFlags struct {
-	Enterprise   RasaCtlEnterpriseFlags
-	StartUpgrade RasaCtlStartUpgradeFlags
-	Start        RasaCtlStartFlags
-	Delete       RasaCtlDeleteFlags
-	Status       RasaCtlStatusFlags
-	ConnectRasa  RasaCtlConnectRasaFlags
-	Global       RasaCtlGlobalFlags
-	Auth         RasaCtlAuthFlags
-	Model        RasaCtlModelFlags
-	Config       RasaCtlConfigFlags
-	Logs         RasaCtlLogsFlags
+	Enterprise          RasaCtlEnterpriseFlags
+	StartUpgrade        RasaCtlStartUpgradeFlags
+	Start               RasaCtlStartFlags
+	Delete              RasaCtlDeleteFlags
+	Status              RasaCtlStatusFlags
+	ConnectRasa         RasaCtlConnectRasaFlags
+	ConnectActionServer RasaCtlConnectActionServerFlags
+	Global              RasaCtlGlobalFlags
+	Auth                RasaCtlAuthFlags
+	Model               RasaCtlModelFlags
+	Config              RasaCtlConfigFlags
+	Logs                RasaCtlLogsFlags
+	Backup              RasaCtlBackupFlags
+	Restore             RasaCtlRestoreFlags
+	Apply               RasaCtlApplyFlags
+	Rollback            RasaCtlRollbackFlags
+	Upgrade             RasaCtlUpgradeFlags
+	Cluster             RasaCtlClusterFlags
+	ActionServer        RasaCtlActionServerFlags
+	Debug               RasaCtlDebugFlags
+	Wait                RasaCtlWaitFlags
 }
 
 type RasaCtlLogsFlags struct {
-	TailLines int64
-	Container string
-	Follow    bool
-	Previous  bool
+	TailLines  int64
+	Container  string
+	Follow     bool
+	Previous   bool
+	Since      time.Duration
+	Timestamps bool
+	Include    []string
+	Exclude    []string
+	All        bool
+	Components []string
+	Selector   string
+	PodQuery   string
+}
+
+// MultiPod returns true if logs are streamed from multiple pods at once.
+func (l RasaCtlLogsFlags) MultiPod() bool {
+	return l.All || len(l.Components) != 0 || l.Selector != "" || l.PodQuery != ""
 }
 
 type RasaCtlEnterpriseFlags struct {
@@ -51,6 +84,7 @@ type RasaCtlEnterpriseFlags struct {
 
 type RasaCtlStartUpgradeFlags struct {
 	ValuesFile string
+	ChartPath  string
 }
 
 type RasaCtlStartFlags struct {
@@ -60,6 +94,8 @@ type RasaCtlStartFlags struct {
 	RasaXPassword      string
 	RasaXPasswordStdin bool
 	UseEdgeRelease     bool
+	PreloadImages      bool
+	SkipPreflight      bool
 }
 
 type RasaCtlDeleteFlags struct {
@@ -76,6 +112,13 @@ type RasaCtlConnectRasaFlags struct {
 	RunSeparateWorker bool
 	Port              int
 	ExtraArgs         []string
+	Docker            bool
+	RasaVersion       string
+	PortForward       bool
+}
+
+type RasaCtlConnectActionServerFlags struct {
+	Port int
 }
 
 type RasaCtlGlobalFlags struct {
@@ -98,6 +141,8 @@ type RasaCtlModelFlags struct {
 	Download struct {
 		Name     string
 		FilePath string
+		All      bool
+		Tag      string
 	}
 	Tag struct {
 		Name  string
@@ -106,8 +151,76 @@ type RasaCtlModelFlags struct {
 	Delete struct {
 		Name string
 	}
+	Watch struct {
+		Tag      string
+		Debounce time.Duration
+	}
+	Prune struct {
+		Keep      int
+		OlderThan time.Duration
+		KeepTags  []string
+		DryRun    bool
+		Force     bool
+	}
 }
 
 type RasaCtlConfigFlags struct {
-	CreateFile bool
+	CreateFile        bool
+	GenerateEndpoints struct {
+		Output            string
+		CredentialsOutput string
+		Address           string
+	}
+}
+
+type RasaCtlBackupFlags struct {
+	Output     string
+	SkipModels bool
+}
+
+type RasaCtlRestoreFlags struct {
+	File       string
+	SkipModels bool
+}
+
+type RasaCtlApplyFlags struct {
+	File   string
+	DryRun bool
+}
+
+type RasaCtlUpgradeFlags struct {
+	DryRun bool
+	Diff   bool
+}
+
+type RasaCtlRollbackFlags struct {
+	Revision int
+}
+
+type RasaCtlClusterFlags struct {
+	Name   string
+	Create struct {
+		KindConfig string
+		Image      string
+	}
+}
+
+type RasaCtlActionServerFlags struct {
+	Deploy struct {
+		ProjectPath string
+		Image       string
+		Tag         string
+	}
+}
+
+type RasaCtlDebugFlags struct {
+	Bundle struct {
+		Output    string
+		TailLines int64
+	}
+}
+
+type RasaCtlWaitFlags struct {
+	For     string
+	Timeout time.Duration
 }
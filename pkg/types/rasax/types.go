@@ -78,6 +78,12 @@ type ModelsListEndpointResponse struct {
 	Models []ModelSpec
 }
 
+// RasaServerStatusResponse stores a response from the /status endpoint of Rasa Server.
+type RasaServerStatusResponse struct {
+	ModelFile   string                 `json:"model_file"`
+	Fingerprint map[string]interface{} `json:"fingerprint"`
+}
+
 type ModelSpec struct {
 	Model        string   `json:"model"`
 	Hash         string   `json:"hash"`
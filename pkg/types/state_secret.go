@@ -15,13 +15,39 @@ limitations under the License.
 */
 package types
 
+import rxtypes "github.com/RasaHQ/rasactl/pkg/types/rasax"
+
 const (
-	StateRasaXVersion      string = "rasa-x-version"
-	StateRasaWorkerVersion string = "rasa-worker-version"
-	StateProjectPath       string = "project-path"
-	StateEnterprise        string = "enterprise"
-	StateHelmChartName     string = "helm-chart-name"
-	StateHelmReleaseName   string = "helm-release-name"
-	StateHelmChartVersion  string = "helm-chart-version"
-	StateHelmReleaseStatus string = "helm-release-status"
+	StateRasaXVersion        string = "rasa-x-version"
+	StateRasaWorkerVersion   string = "rasa-worker-version"
+	StateProjectPath         string = "project-path"
+	StateEnterprise          string = "enterprise"
+	StateHelmChartName       string = "helm-chart-name"
+	StateHelmReleaseName     string = "helm-release-name"
+	StateHelmChartVersion    string = "helm-chart-version"
+	StateHelmReleaseStatus   string = "helm-release-status"
+	StateReplicas            string = "replicas"
+	StateConnectRasa         string = "connect-rasa"
+	StateConnectActionServer string = "connect-action-server"
 )
+
+// ReplicasState stores replica counts for deployments and statefulsets,
+// a key has the <kind>/<name> form, e.g. deployment/rasa-x-rasa-x.
+type ReplicasState map[string]int32
+
+// ConnectRasaState stores the configuration of a deployment from before
+// a Rasa server was connected to it with 'rasactl connect rasa'.
+type ConnectRasaState struct {
+	// Values stores helm values that are changed when a Rasa server is connected.
+	Values map[string]interface{} `json:"values"`
+	// RasaXConfig stores data of the config map with Rasa X configuration files.
+	RasaXConfig map[string]string `json:"rasaXConfig"`
+	// Environments stores Rasa X environments, they're restored if Rasa Enterprise is active.
+	Environments []rxtypes.EnvironmentsEndpointRequest `json:"environments"`
+}
+
+// ConnectActionServerState stores helm values of a deployment from before
+// a local action server was connected to it with 'rasactl connect action-server'.
+type ConnectActionServerState struct {
+	Values map[string]interface{} `json:"values"`
+}
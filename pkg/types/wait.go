@@ -0,0 +1,66 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package types
+
+import "golang.org/x/xerrors"
+
+// Conditions that the 'rasactl wait' command waits for, each condition includes the previous ones.
+const (
+	WaitConditionRunning    string = "running"
+	WaitConditionMigrated   string = "migrated"
+	WaitConditionProduction string = "production"
+	WaitConditionModel      string = "model"
+)
+
+// WaitConditions lists the supported wait conditions in the order they are reached.
+var WaitConditions = []string{
+	WaitConditionRunning,
+	WaitConditionMigrated,
+	WaitConditionProduction,
+	WaitConditionModel,
+}
+
+// Exit codes returned by rasactl.
+const (
+	ExitCodeError     int = 1
+	ExitCodeTimeout   int = 2
+	ExitCodeNotFound  int = 3
+	ExitCodeUnhealthy int = 4
+)
+
+// ExitError is an error that sets a specific exit code for rasactl.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns an exit code for a given error, it's ExitCodeError
+// if the error doesn't wrap an ExitError.
+func ExitCode(err error) int {
+	var exitError *ExitError
+	if xerrors.As(err, &exitError) {
+		return exitError.Code
+	}
+	return ExitCodeError
+}
@@ -0,0 +1,141 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/xerrors"
+)
+
+// CreateArchive creates a gzipped tar archive with a flat list of files.
+// The files map uses a name of the file within the archive as a key and a local path as a value.
+func CreateArchive(archive string, files map[string]string) error {
+	f, err := os.Create(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gw := gzip.NewWriter(f)
+	defer gw.Close()
+
+	tw := tar.NewWriter(gw)
+	defer tw.Close()
+
+	for name, path := range files {
+		if err := addFileToArchive(tw, name, path); err != nil {
+			return err
+		}
+	}
+
+	// The deferred calls only clean up on the error path, the archive is
+	// complete only if the tar footer and gzip trailer were flushed to the file.
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	if err := gw.Close(); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+func addFileToArchive(tw *tar.Writer, name string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	header, err := tar.FileInfoHeader(stat, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tw, file)
+	return err
+}
+
+// ExtractArchive extracts a gzipped tar archive created by CreateArchive to a given directory.
+// It returns a map that uses a name of the file within the archive as a key and a local path as a value.
+func ExtractArchive(archive string, dir string) (map[string]string, error) {
+	files := map[string]string{}
+
+	f, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, xerrors.Errorf("can't read the %s archive: %w", archive, err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// The archive has a flat structure, use only a base name
+		// to make sure that files are not written outside the directory.
+		name := filepath.Base(header.Name)
+		path := filepath.Join(dir, name)
+
+		out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+		if err != nil {
+			return nil, err
+		}
+
+		//nolint:gosec
+		if _, err := io.Copy(out, tr); err != nil {
+			out.Close()
+			return nil, err
+		}
+		out.Close()
+
+		files[name] = path
+	}
+
+	return files, nil
+}
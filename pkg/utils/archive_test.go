@@ -0,0 +1,54 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("Archive", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "rasactl-archive-test-")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("create and extract an archive", func() {
+		file := filepath.Join(dir, "test.txt")
+		Expect(ioutil.WriteFile(file, []byte("test"), 0600)).To(Succeed())
+
+		archive := filepath.Join(dir, "test.tar.gz")
+		Expect(utils.CreateArchive(archive, map[string]string{"data.txt": file})).To(Succeed())
+
+		extractDir := filepath.Join(dir, "extract")
+		Expect(os.Mkdir(extractDir, 0755)).To(Succeed())
+
+		files, err := utils.ExtractArchive(archive, extractDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveKeyWithValue("data.txt", filepath.Join(extractDir, "data.txt")))
+
+		data, err := ioutil.ReadFile(files["data.txt"])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal("test"))
+	})
+
+	It("return an error if a file is not an archive", func() {
+		file := filepath.Join(dir, "test.txt")
+		Expect(ioutil.WriteFile(file, []byte("test"), 0600)).To(Succeed())
+
+		_, err := utils.ExtractArchive(file, dir)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,26 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("Diff", func() {
+
+	It("return a unified diff for changed strings", func() {
+		diff, err := utils.UnifiedDiff("a: 1\nb: 2\n", "a: 1\nb: 3\n", "from", "to")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff).To(ContainSubstring("--- from"))
+		Expect(diff).To(ContainSubstring("+++ to"))
+		Expect(diff).To(ContainSubstring("-b: 2"))
+		Expect(diff).To(ContainSubstring("+b: 3"))
+	})
+
+	It("return an empty diff for equal strings", func() {
+		diff, err := utils.UnifiedDiff("a: 1\n", "a: 1\n", "from", "to")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff).To(BeEmpty())
+	})
+})
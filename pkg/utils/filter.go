@@ -0,0 +1,73 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"regexp"
+
+	"golang.org/x/xerrors"
+)
+
+// LineFilter filters lines by regular expressions.
+type LineFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// NewLineFilter returns a filter that matches lines that match at least one of the include expressions,
+// or any line if there are no include expressions, and none of the exclude expressions.
+func NewLineFilter(include, exclude []string) (*LineFilter, error) {
+	filter := &LineFilter{}
+
+	for _, expr := range include {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid include expression %q: %w", expr, err)
+		}
+		filter.include = append(filter.include, re)
+	}
+
+	for _, expr := range exclude {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid exclude expression %q: %w", expr, err)
+		}
+		filter.exclude = append(filter.exclude, re)
+	}
+
+	return filter, nil
+}
+
+// Match returns true if a given line passes the filter.
+func (f *LineFilter) Match(line string) bool {
+	for _, re := range f.exclude {
+		if re.MatchString(line) {
+			return false
+		}
+	}
+
+	if len(f.include) == 0 {
+		return true
+	}
+
+	for _, re := range f.include {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+
+	return false
+}
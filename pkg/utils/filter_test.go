@@ -0,0 +1,37 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("LineFilter", func() {
+
+	It("match all lines if there are no expressions", func() {
+		filter, err := utils.NewLineFilter(nil, nil)
+		Expect(err).To(BeNil())
+		Expect(filter.Match("INFO rasa.core.processor")).To(BeTrue())
+	})
+
+	It("match only included lines", func() {
+		filter, err := utils.NewLineFilter([]string{"ERROR", "WARN"}, nil)
+		Expect(err).To(BeNil())
+		Expect(filter.Match("ERROR can't connect to the tracker store")).To(BeTrue())
+		Expect(filter.Match("WARN slow response")).To(BeTrue())
+		Expect(filter.Match("INFO health check")).To(BeFalse())
+	})
+
+	It("exclude lines even if they are included", func() {
+		filter, err := utils.NewLineFilter([]string{"GET"}, []string{"/health"})
+		Expect(err).To(BeNil())
+		Expect(filter.Match("GET /api/version")).To(BeTrue())
+		Expect(filter.Match("GET /health")).To(BeFalse())
+	})
+
+	It("error on an invalid expression", func() {
+		_, err := utils.NewLineFilter(nil, []string{"("})
+		Expect(err).To(Not(BeNil()))
+	})
+})
@@ -0,0 +1,68 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"sort"
+
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"sigs.k8s.io/yaml"
+)
+
+// ImagesFromManifest returns sorted and unique container images used by Kubernetes resources in a given manifest.
+func ImagesFromManifest(manifest string) ([]string, error) {
+	seen := map[string]bool{}
+
+	for _, m := range releaseutil.SplitManifests(manifest) {
+		var resource interface{}
+		if err := yaml.Unmarshal([]byte(m), &resource); err != nil {
+			return nil, err
+		}
+		findImages(resource, seen)
+	}
+
+	images := []string{}
+	for image := range seen {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+
+	return images, nil
+}
+
+// findImages looks for images defined in 'containers' and 'initContainers' lists.
+func findImages(data interface{}, images map[string]bool) {
+	switch d := data.(type) {
+	case map[string]interface{}:
+		for key, value := range d {
+			if containers, ok := value.([]interface{}); ok && (key == "containers" || key == "initContainers") {
+				for _, c := range containers {
+					if container, ok := c.(map[string]interface{}); ok {
+						if image, ok := container["image"].(string); ok && image != "" {
+							images[image] = true
+						}
+					}
+				}
+				continue
+			}
+			findImages(value, images)
+		}
+	case []interface{}:
+		for _, value := range d {
+			findImages(value, images)
+		}
+	}
+}
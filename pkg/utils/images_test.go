@@ -0,0 +1,56 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("Images", func() {
+
+	It("return images used by containers and init containers", func() {
+		manifest := `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: rasa-x
+spec:
+  template:
+    spec:
+      initContainers:
+        - name: init
+          image: alpine:3.14
+      containers:
+        - name: rasa-x
+          image: rasa/rasa-x:1.0.0
+          env:
+            - name: image
+              value: not-an-image
+---
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: postgresql
+spec:
+  template:
+    spec:
+      containers:
+        - name: postgresql
+          image: docker.io/bitnami/postgresql:11
+        - name: sidecar
+          image: alpine:3.14
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  image: not-an-image
+`
+		images, err := utils.ImagesFromManifest(manifest)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(images).To(Equal([]string{"alpine:3.14", "docker.io/bitnami/postgresql:11", "rasa/rasa-x:1.0.0"}))
+	})
+})
@@ -0,0 +1,26 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"github.com/RasaHQ/rasactl/kind"
+)
+
+// KindClusterConfig returns configuration for a kind cluster that is used by rasactl.
+// The configuration is kind/config.yaml generated by the kind/generate-config.sh script.
+func KindClusterConfig() string {
+	return kind.Config
+}
@@ -0,0 +1,38 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("Kind", func() {
+
+	It("generate configuration for a kind cluster - KindClusterConfig", func() {
+		var config struct {
+			Kind  string `json:"kind"`
+			Nodes []struct {
+				Role              string `json:"role"`
+				ExtraPortMappings []struct {
+					ContainerPort int `json:"containerPort"`
+					HostPort      int `json:"hostPort"`
+				} `json:"extraPortMappings"`
+			} `json:"nodes"`
+		}
+
+		err := yaml.Unmarshal([]byte(utils.KindClusterConfig()), &config)
+		Expect(err).To(BeNil())
+		Expect(config.Kind).To(Equal("Cluster"))
+		Expect(config.Nodes).To(HaveLen(1))
+		Expect(config.Nodes[0].Role).To(Equal("control-plane"))
+
+		// 80, 443 and the 30000-30100 node port range
+		mappings := config.Nodes[0].ExtraPortMappings
+		Expect(mappings).To(HaveLen(103))
+		Expect(mappings[0].HostPort).To(Equal(80))
+		Expect(mappings[2].ContainerPort).To(Equal(30000))
+		Expect(mappings[102].HostPort).To(Equal(30100))
+	})
+})
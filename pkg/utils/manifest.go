@@ -0,0 +1,145 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+)
+
+// ReadManifest reads and validates a deployment manifest.
+// Relative paths used in the manifest are resolved against the manifest directory.
+func ReadManifest(file string) (*types.DeploymentManifest, error) {
+	manifest := &types.DeploymentManifest{}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.UnmarshalStrict(data, manifest); err != nil {
+		return nil, xerrors.Errorf("can't parse the %s manifest: %w", file, err)
+	}
+
+	if err := validateManifest(manifest); err != nil {
+		return nil, xerrors.Errorf("the %s manifest is invalid: %w", file, err)
+	}
+
+	baseDir, err := filepath.Abs(filepath.Dir(file))
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &manifest.Spec
+	for i, valuesFile := range spec.ValuesFiles {
+		spec.ValuesFiles[i] = absPath(baseDir, valuesFile)
+	}
+	spec.ProjectPath = absPath(baseDir, spec.ProjectPath)
+
+	for _, source := range []*types.ValueSource{spec.Enterprise.License, spec.RasaX.Password} {
+		if source != nil {
+			source.File = absPath(baseDir, source.File)
+		}
+	}
+
+	return manifest, nil
+}
+
+func validateManifest(manifest *types.DeploymentManifest) error {
+	if manifest.APIVersion != types.ManifestAPIVersion {
+		return xerrors.Errorf("unsupported apiVersion %q, supported version is %q",
+			manifest.APIVersion, types.ManifestAPIVersion)
+	}
+
+	if manifest.Kind != types.ManifestKindDeployment {
+		return xerrors.Errorf("unsupported kind %q, supported kind is %q",
+			manifest.Kind, types.ManifestKindDeployment)
+	}
+
+	if err := ValidateName(manifest.Metadata.Name); err != nil {
+		return err
+	}
+
+	if err := HelmChartVersionConstrains(manifest.Spec.ChartVersion); err != nil {
+		return err
+	}
+
+	for name, source := range map[string]*types.ValueSource{
+		"spec.enterprise.license": manifest.Spec.Enterprise.License,
+		"spec.rasaX.password":     manifest.Spec.RasaX.Password,
+	} {
+		if err := validateValueSource(source); err != nil {
+			return xerrors.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateValueSource(source *types.ValueSource) error {
+	if source == nil {
+		return nil
+	}
+
+	fields := 0
+	for _, field := range []string{source.Value, source.Env, source.File} {
+		if field != "" {
+			fields++
+		}
+	}
+
+	if fields != 1 {
+		return xerrors.Errorf("exactly one of 'value', 'env' or 'file' has to be set")
+	}
+
+	return nil
+}
+
+func absPath(baseDir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
+
+// ReadValueSource returns a value defined by a given source.
+func ReadValueSource(source *types.ValueSource) (string, error) {
+	switch {
+	case source == nil:
+		return "", nil
+	case source.Env != "":
+		value, ok := os.LookupEnv(source.Env)
+		if !ok {
+			return "", xerrors.Errorf("the %s environment variable is not set", source.Env)
+		}
+		return value, nil
+	case source.File != "":
+		data, err := ioutil.ReadFile(source.File)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	default:
+		return source.Value, nil
+	}
+}
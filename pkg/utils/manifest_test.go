@@ -0,0 +1,134 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/RasaHQ/rasactl/pkg/types"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("Manifest", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "rasactl-manifest-test-")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeManifest := func(data string) string {
+		file := filepath.Join(dir, "deployment.yaml")
+		Expect(ioutil.WriteFile(file, []byte(data), 0600)).To(Succeed())
+		return file
+	}
+
+	It("read a manifest and resolve relative paths", func() {
+		file := writeManifest(`
+apiVersion: rasactl.rasa.com/v1alpha1
+kind: Deployment
+metadata:
+  name: test-deployment
+spec:
+  chartVersion: 4.3.3
+  valuesFiles:
+    - values.yaml
+    - /tmp/values.yaml
+  projectPath: ./project
+  enterprise:
+    license:
+      file: license.txt
+  rasaX:
+    password:
+      env: RASA_X_PASSWORD
+`)
+
+		manifest, err := utils.ReadManifest(file)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifest.Metadata.Name).To(Equal("test-deployment"))
+		Expect(manifest.Spec.ChartVersion).To(Equal("4.3.3"))
+		Expect(manifest.Spec.ValuesFiles).To(Equal([]string{filepath.Join(dir, "values.yaml"), "/tmp/values.yaml"}))
+		Expect(manifest.Spec.ProjectPath).To(Equal(filepath.Join(dir, "project")))
+		Expect(manifest.Spec.Enterprise.License.File).To(Equal(filepath.Join(dir, "license.txt")))
+		Expect(manifest.Spec.RasaX.Password.Env).To(Equal("RASA_X_PASSWORD"))
+	})
+
+	invalidManifests := map[string]string{
+		"unsupported apiVersion": `
+apiVersion: v1
+kind: Deployment
+metadata:
+  name: test
+`,
+		"unsupported kind": `
+apiVersion: rasactl.rasa.com/v1alpha1
+kind: Pod
+metadata:
+  name: test
+`,
+		"invalid name": `
+apiVersion: rasactl.rasa.com/v1alpha1
+kind: Deployment
+metadata:
+  name: Test_Deployment
+`,
+		"unknown field": `
+apiVersion: rasactl.rasa.com/v1alpha1
+kind: Deployment
+metadata:
+  name: test
+spec:
+  unknown: true
+`,
+		"more than one value source field": `
+apiVersion: rasactl.rasa.com/v1alpha1
+kind: Deployment
+metadata:
+  name: test
+spec:
+  rasaX:
+    password:
+      value: test
+      env: RASA_X_PASSWORD
+`,
+	}
+
+	for name, data := range invalidManifests {
+		name, data := name, data
+		It("return an error for an invalid manifest: "+name, func() {
+			_, err := utils.ReadManifest(writeManifest(data))
+			Expect(err).To(HaveOccurred())
+		})
+	}
+
+	It("read a value from a value source", func() {
+		file := filepath.Join(dir, "license.txt")
+		Expect(ioutil.WriteFile(file, []byte("license\n"), 0600)).To(Succeed())
+		os.Setenv("RASACTL_TEST_VALUE", "env-value")
+		defer os.Unsetenv("RASACTL_TEST_VALUE")
+
+		value, err := utils.ReadValueSource(&types.ValueSource{File: file})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal("license"))
+
+		value, err = utils.ReadValueSource(&types.ValueSource{Env: "RASACTL_TEST_VALUE"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal("env-value"))
+
+		value, err = utils.ReadValueSource(&types.ValueSource{Value: "value"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal("value"))
+
+		_, err = utils.ReadValueSource(&types.ValueSource{Env: "RASACTL_TEST_NOT_SET"})
+		Expect(err).To(HaveOccurred())
+	})
+})
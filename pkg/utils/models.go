@@ -0,0 +1,94 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	rtypes "github.com/RasaHQ/rasactl/pkg/types/rasax"
+)
+
+// ModelPruneRule defines which models are kept when models are pruned.
+type ModelPruneRule struct {
+	// Keep is the number of the newest models to keep, the rule is not used if 0.
+	Keep int
+
+	// OlderThan defines that only models older than the duration are deleted, the rule is not used if 0.
+	OlderThan time.Duration
+
+	// KeepTags defines tags of models that are never deleted, in addition to the 'production' tag.
+	KeepTags []string
+}
+
+// ModelPruneDecision stores information on whether a model is deleted, and why.
+type ModelPruneDecision struct {
+	Model  rtypes.ModelSpec
+	Delete bool
+	Reason string
+}
+
+// ModelTrainedAt converts the ModelSpec.TrainedAt field to time.
+func ModelTrainedAt(model rtypes.ModelSpec) time.Time {
+	sec, dec := math.Modf(model.TrainedAt)
+	return time.Unix(int64(sec), int64(dec*(1e9)))
+}
+
+// ModelsToPrune returns decisions for given models, sorted from the newest model.
+// A model is deleted only if none of the rules keeps it, models tagged 'production' are always kept.
+func ModelsToPrune(models []rtypes.ModelSpec, rule ModelPruneRule, now time.Time) []ModelPruneDecision {
+	sorted := make([]rtypes.ModelSpec, len(models))
+	copy(sorted, models)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TrainedAt > sorted[j].TrainedAt
+	})
+
+	keepTags := append([]string{"production"}, rule.KeepTags...)
+
+	decisions := []ModelPruneDecision{}
+	for i, model := range sorted {
+		decision := ModelPruneDecision{Model: model, Delete: true, Reason: "matches the prune rules"}
+
+		switch {
+		case modelTag(model, keepTags) != "":
+			decision.Delete = false
+			decision.Reason = fmt.Sprintf("tagged as '%s'", modelTag(model, keepTags))
+		case rule.Keep > 0 && i < rule.Keep:
+			decision.Delete = false
+			decision.Reason = fmt.Sprintf("one of the %d newest models", rule.Keep)
+		case rule.OlderThan > 0 && now.Sub(ModelTrainedAt(model)) <= rule.OlderThan:
+			decision.Delete = false
+			decision.Reason = fmt.Sprintf("not older than %s", rule.OlderThan)
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	return decisions
+}
+
+// modelTag returns the first of given tags that a model is tagged with.
+func modelTag(model rtypes.ModelSpec, tags []string) string {
+	for _, tag := range tags {
+		if StringSliceContains(model.Tags, strings.TrimSpace(tag)) {
+			return tag
+		}
+	}
+	return ""
+}
@@ -0,0 +1,65 @@
+package utils_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	rtypes "github.com/RasaHQ/rasactl/pkg/types/rasax"
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("Models", func() {
+
+	now := time.Unix(1000000, 0)
+	day := float64(24 * 60 * 60)
+	models := []rtypes.ModelSpec{
+		{Model: "model-3", TrainedAt: float64(now.Unix()) - 3*day},
+		{Model: "model-1", TrainedAt: float64(now.Unix()) - 1*day},
+		{Model: "model-4", TrainedAt: float64(now.Unix()) - 4*day, Tags: []string{"production"}},
+		{Model: "model-2", TrainedAt: float64(now.Unix()) - 2*day},
+	}
+
+	deleted := func(decisions []utils.ModelPruneDecision) []string {
+		names := []string{}
+		for _, decision := range decisions {
+			if decision.Delete {
+				names = append(names, decision.Model.Model)
+			}
+		}
+		return names
+	}
+
+	It("sort models from the newest - ModelsToPrune", func() {
+		decisions := utils.ModelsToPrune(models, utils.ModelPruneRule{Keep: 1}, now)
+		Expect(decisions).To(HaveLen(4))
+		Expect(decisions[0].Model.Model).To(Equal("model-1"))
+		Expect(decisions[3].Model.Model).To(Equal("model-4"))
+	})
+
+	It("keep the newest models and models with a tag - ModelsToPrune", func() {
+		decisions := utils.ModelsToPrune(models, utils.ModelPruneRule{Keep: 2, KeepTags: []string{"production"}}, now)
+		Expect(deleted(decisions)).To(Equal([]string{"model-3"}))
+	})
+
+	It("delete models older than a duration - ModelsToPrune", func() {
+		decisions := utils.ModelsToPrune(models, utils.ModelPruneRule{OlderThan: 36 * time.Hour}, now)
+		Expect(deleted(decisions)).To(Equal([]string{"model-2", "model-3"}))
+	})
+
+	It("always keep models tagged as 'production' - ModelsToPrune", func() {
+		decisions := utils.ModelsToPrune(models, utils.ModelPruneRule{OlderThan: time.Hour, KeepTags: []string{"staging"}}, now)
+		Expect(deleted(decisions)).To(Equal([]string{"model-1", "model-2", "model-3"}))
+		Expect(decisions[3].Reason).To(Equal("tagged as 'production'"))
+	})
+
+	It("keep a model if any of the rules keeps it - ModelsToPrune", func() {
+		decisions := utils.ModelsToPrune(models, utils.ModelPruneRule{
+			Keep:      1,
+			OlderThan: 60 * time.Hour,
+			KeepTags:  []string{"production"},
+		}, now)
+		Expect(deleted(decisions)).To(Equal([]string{"model-3"}))
+	})
+})
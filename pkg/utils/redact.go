@@ -0,0 +1,89 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import "regexp"
+
+// RedactedValue replaces sensitive values.
+const RedactedValue string = "[REDACTED]"
+
+var (
+	sensitiveKeyRegexp = regexp.MustCompile(
+		`(?i)(password|passwd|secret|token|license|credential|api_?key|private_?key|cookie|dsn|connection_?string|auth(orization)?$)`,
+	)
+
+	// Keys that only reference sensitive data, e.g. names of Kubernetes secrets, are not redacted.
+	referenceKeyRegexp = regexp.MustCompile(`(?i)(^existing|secretname$|passwordkey$|tokenkey$)`)
+)
+
+// RedactValues returns a copy of helm values with passwords, tokens and other sensitive values replaced.
+// A value is sensitive if its key looks sensitive, or if it's an environment variable with a sensitive name.
+func RedactValues(values map[string]interface{}) map[string]interface{} {
+	return redactMap(values)
+}
+
+func redactMap(values map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(values))
+
+	// Environment variables are defined as name/value pairs.
+	envName, _ := values["name"].(string)
+
+	for key, value := range values {
+		if isSensitiveKey(key) || (key == "value" && isSensitiveKey(envName)) {
+			result[key] = redactScalar(value)
+			continue
+		}
+		result[key] = redactValue(value)
+	}
+
+	return result
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return redactMap(v)
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = redactValue(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// redactScalar replaces a non-empty value, booleans are kept as they only enable or disable features.
+func redactScalar(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil, bool:
+		return value
+	case string:
+		if v == "" {
+			return v
+		}
+		return RedactedValue
+	case map[string]interface{}, []interface{}:
+		return redactValue(value)
+	default:
+		return RedactedValue
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	return sensitiveKeyRegexp.MatchString(key) && !referenceKeyRegexp.MatchString(key)
+}
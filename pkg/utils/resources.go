@@ -0,0 +1,71 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	v1 "k8s.io/api/core/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	resourcehelper "k8s.io/kubectl/pkg/util/resource"
+)
+
+// workload is a subset of fields that are common for Deployments and StatefulSets.
+type workload struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Replicas *int32             `json:"replicas"`
+		Template v1.PodTemplateSpec `json:"template"`
+	} `json:"spec"`
+}
+
+// ManifestResourceRequests returns the sum of resources requested by pods
+// of Deployments and StatefulSets defined in a given manifest, e.g. a manifest rendered by helm.
+func ManifestResourceRequests(manifest string) (v1.ResourceList, error) {
+	requests := v1.ResourceList{}
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(manifest), 4096)
+	for {
+		w := workload{}
+		if err := decoder.Decode(&w); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if w.Kind != "Deployment" && w.Kind != "StatefulSet" {
+			continue
+		}
+
+		replicas := int64(1)
+		if w.Spec.Replicas != nil {
+			replicas = int64(*w.Spec.Replicas)
+		}
+
+		podRequests, _ := resourcehelper.PodRequestsAndLimits(&v1.Pod{Spec: w.Spec.Template.Spec})
+		for name, quantity := range podRequests {
+			value := requests[name]
+			for i := int64(0); i < replicas; i++ {
+				value.Add(quantity)
+			}
+			requests[name] = value
+		}
+	}
+
+	return requests, nil
+}
@@ -0,0 +1,76 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("ManifestResourceRequests", func() {
+
+	manifest := `
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: rasa-x
+spec:
+  ports:
+    - port: 5002
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: rasa-x
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+        - name: rasa-x
+          resources:
+            requests:
+              cpu: 250m
+              memory: 512Mi
+---
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: postgresql
+spec:
+  template:
+    spec:
+      initContainers:
+        - name: init
+          resources:
+            requests:
+              memory: 1Gi
+      containers:
+        - name: postgresql
+          resources:
+            requests:
+              cpu: 100m
+              memory: 256Mi
+`
+
+	It("sum requests of all replicas", func() {
+		requests, err := utils.ManifestResourceRequests(manifest)
+		Expect(err).To(BeNil())
+
+		cpu := requests[v1.ResourceCPU]
+		Expect(cpu.Cmp(resource.MustParse("600m"))).To(Equal(0))
+
+		// The init container requests more memory than the postgresql container.
+		memory := requests[v1.ResourceMemory]
+		Expect(memory.Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+	})
+
+	It("return no requests for an empty manifest", func() {
+		requests, err := utils.ManifestResourceRequests("")
+		Expect(err).To(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+})
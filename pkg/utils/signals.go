@@ -0,0 +1,45 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+)
+
+// localSignalHandlers counts commands that handle SIGINT and SIGTERM on their own.
+var localSignalHandlers int32
+
+// NotifySignals relays SIGINT and SIGTERM to the returned channel. While the channel is active,
+// the global signal handler doesn't exit rasactl, so that the caller can clean up before it returns.
+// The returned function stops relaying signals, and has to be called once the caller is done.
+func NotifySignals() (<-chan os.Signal, func()) {
+	sigs := make(chan os.Signal, 1)
+	atomic.AddInt32(&localSignalHandlers, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	return sigs, func() {
+		signal.Stop(sigs)
+		atomic.AddInt32(&localSignalHandlers, -1)
+	}
+}
+
+// IsSignalHandledLocally returns 'true' if a signal is handled by a command that uses NotifySignals.
+func IsSignalHandledLocally() bool {
+	return atomic.LoadInt32(&localSignalHandlers) > 0
+}
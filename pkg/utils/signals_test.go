@@ -0,0 +1,21 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("NotifySignals", func() {
+
+	It("handle signals locally until stopped", func() {
+		Expect(utils.IsSignalHandledLocally()).To(BeFalse())
+
+		_, stop := utils.NotifySignals()
+		Expect(utils.IsSignalHandledLocally()).To(BeTrue())
+
+		stop()
+		Expect(utils.IsSignalHandledLocally()).To(BeFalse())
+	})
+})
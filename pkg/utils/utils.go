@@ -311,6 +311,16 @@ func RasaXVersionConstrains(version string, constraint string) bool {
 	return c.Check(v)
 }
 
+// StringSliceContains checks if a given string slice contains a string.
+func StringSliceContains(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
 // StringSliceToJSON converts [][]string{} to JSON.
 func StringSliceToJSON(d [][]string) (string, error) {
 
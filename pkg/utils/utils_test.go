@@ -57,6 +57,12 @@ var _ = Describe("Utils", func() {
 		Expect(str).To(Equal("{\"test\":\"test\"}"))
 	})
 
+	It("check if string slice contains a string - StringSliceContains", func() {
+		Expect(utils.StringSliceContains([]string{"production", "latest"}, "production")).To(BeTrue())
+		Expect(utils.StringSliceContains([]string{"production", "latest"}, "staging")).To(BeFalse())
+		Expect(utils.StringSliceContains(nil, "production")).To(BeFalse())
+	})
+
 	Describe("read Rasa X URL from environment variables", func() {
 		viper.AutomaticEnv() // read in environment variables that match
 		viper.SetEnvPrefix("rasactl")
@@ -0,0 +1,73 @@
+/*
+Copyright © 2021 Rasa Technologies GmbH
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// PrefixWriter is a writer that prefixes each line with a given string.
+type PrefixWriter struct {
+	w      io.Writer
+	prefix []byte
+	buf    bytes.Buffer
+	mu     sync.Mutex
+}
+
+// NewPrefixWriter returns a writer that writes to w and prefixes each line with prefix.
+// Incomplete lines are buffered until a newline is written or the writer is flushed.
+func NewPrefixWriter(w io.Writer, prefix string) *PrefixWriter {
+	return &PrefixWriter{w: w, prefix: []byte(prefix)}
+}
+
+// Write implements io.Writer.
+func (p *PrefixWriter) Write(data []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.buf.Write(data)
+	for {
+		i := bytes.IndexByte(p.buf.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		if err := p.writeLine(p.buf.Next(i + 1)); err != nil {
+			return len(data), err
+		}
+	}
+
+	return len(data), nil
+}
+
+// Flush writes buffered data that doesn't end with a newline.
+func (p *PrefixWriter) Flush() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.buf.Len() == 0 {
+		return nil
+	}
+
+	line := append(p.buf.Next(p.buf.Len()), '\n')
+	return p.writeLine(line)
+}
+
+func (p *PrefixWriter) writeLine(line []byte) error {
+	_, err := p.w.Write(append(append([]byte{}, p.prefix...), line...))
+	return err
+}
@@ -0,0 +1,38 @@
+package utils_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/RasaHQ/rasactl/pkg/utils"
+)
+
+var _ = Describe("PrefixWriter", func() {
+
+	It("prefix each line", func() {
+		out := new(bytes.Buffer)
+		w := utils.NewPrefixWriter(out, "(production) ")
+
+		_, err := w.Write([]byte("first line\nsecond "))
+		Expect(err).To(BeNil())
+		Expect(out.String()).To(Equal("(production) first line\n"))
+
+		_, err = w.Write([]byte("line\n"))
+		Expect(err).To(BeNil())
+		Expect(out.String()).To(Equal("(production) first line\n(production) second line\n"))
+	})
+
+	It("flush an incomplete line", func() {
+		out := new(bytes.Buffer)
+		w := utils.NewPrefixWriter(out, "(worker) ")
+
+		_, err := w.Write([]byte("no newline"))
+		Expect(err).To(BeNil())
+		Expect(out.String()).To(BeEmpty())
+
+		Expect(w.Flush()).To(BeNil())
+		Expect(out.String()).To(Equal("(worker) no newline\n"))
+	})
+})